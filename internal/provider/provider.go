@@ -97,6 +97,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/inspector2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/iot"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafka"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/kafkaconnect"
@@ -531,6 +532,7 @@ func Provider() *schema.Provider {
 			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
 			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
 			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
+			"aws_ec2_cloudinit_config":                       ec2.DataSourceCloudInitConfig(),
 			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
 			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
 			"aws_ec2_host":                                   ec2.DataSourceHost(),
@@ -566,6 +568,7 @@ func Provider() *schema.Provider {
 			"aws_internet_gateway":                           ec2.DataSourceInternetGateway(),
 			"aws_key_pair":                                   ec2.DataSourceKeyPair(),
 			"aws_launch_template":                            ec2.DataSourceLaunchTemplate(),
+			"aws_launch_template_versions":                   ec2.DataSourceLaunchTemplateVersions(),
 			"aws_nat_gateway":                                ec2.DataSourceNATGateway(),
 			"aws_nat_gateways":                               ec2.DataSourceNATGateways(),
 			"aws_network_acls":                               ec2.DataSourceNetworkACLs(),
@@ -668,8 +671,10 @@ func Provider() *schema.Provider {
 			"aws_iam_user_ssh_key":            iam.DataSourceUserSSHKey(),
 			"aws_iam_users":                   iam.DataSourceUsers(),
 
-			"aws_identitystore_group": identitystore.DataSourceGroup(),
-			"aws_identitystore_user":  identitystore.DataSourceUser(),
+			"aws_identitystore_group":  identitystore.DataSourceGroup(),
+			"aws_identitystore_groups": identitystore.DataSourceGroups(),
+			"aws_identitystore_user":   identitystore.DataSourceUser(),
+			"aws_identitystore_users":  identitystore.DataSourceUsers(),
 
 			"aws_imagebuilder_component":                     imagebuilder.DataSourceComponent(),
 			"aws_imagebuilder_components":                    imagebuilder.DataSourceComponents(),
@@ -708,9 +713,10 @@ func Provider() *schema.Provider {
 			"aws_kms_secret":     kms.DataSourceSecret(),
 			"aws_kms_secrets":    kms.DataSourceSecrets(),
 
-			"aws_lakeformation_data_lake_settings": lakeformation.DataSourceDataLakeSettings(),
-			"aws_lakeformation_permissions":        lakeformation.DataSourcePermissions(),
-			"aws_lakeformation_resource":           lakeformation.DataSourceResource(),
+			"aws_lakeformation_data_lake_settings":   lakeformation.DataSourceDataLakeSettings(),
+			"aws_lakeformation_permissions":          lakeformation.DataSourcePermissions(),
+			"aws_lakeformation_resource":             lakeformation.DataSourceResource(),
+			"aws_lakeformation_resource_permissions": lakeformation.DataSourceResourcePermissions(),
 
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
@@ -761,6 +767,7 @@ func Provider() *schema.Provider {
 
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
+			"aws_organizations_effective_policy":         organizations.DataSourceEffectivePolicy(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
 			"aws_organizations_organizational_units":     organizations.DataSourceOrganizationalUnits(),
 			"aws_organizations_resource_tags":            organizations.DataSourceResourceTags(),
@@ -1012,6 +1019,7 @@ func Provider() *schema.Provider {
 			"aws_backup_vault_policy":             backup.ResourceVaultPolicy(),
 
 			"aws_batch_compute_environment": batch.ResourceComputeEnvironment(),
+			"aws_batch_job":                 batch.ResourceJob(),
 			"aws_batch_job_definition":      batch.ResourceJobDefinition(),
 			"aws_batch_job_queue":           batch.ResourceJobQueue(),
 			"aws_batch_scheduling_policy":   batch.ResourceSchedulingPolicy(),
@@ -1180,9 +1188,10 @@ func Provider() *schema.Provider {
 			"aws_devicefarm_test_grid_project": devicefarm.ResourceTestGridProject(),
 			"aws_devicefarm_upload":            devicefarm.ResourceUpload(),
 
-			"aws_detective_graph":               detective.ResourceGraph(),
-			"aws_detective_invitation_accepter": detective.ResourceInvitationAccepter(),
-			"aws_detective_member":              detective.ResourceMember(),
+			"aws_detective_graph":                      detective.ResourceGraph(),
+			"aws_detective_invitation_accepter":        detective.ResourceInvitationAccepter(),
+			"aws_detective_member":                     detective.ResourceMember(),
+			"aws_detective_organization_admin_account": detective.ResourceOrganizationAdminAccount(),
 
 			"aws_dx_bgp_peer":                                  directconnect.ResourceBGPPeer(),
 			"aws_dx_connection":                                directconnect.ResourceConnection(),
@@ -1344,6 +1353,7 @@ func Provider() *schema.Provider {
 			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
 			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
 
+			"aws_ecr_image_copy":                      ecr.ResourceImageCopy(),
 			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
 			"aws_ecr_registry_policy":                 ecr.ResourceRegistryPolicy(),
@@ -1433,8 +1443,9 @@ func Provider() *schema.Provider {
 
 			"aws_kinesis_firehose_delivery_stream": firehose.ResourceDeliveryStream(),
 
-			"aws_fms_admin_account": fms.ResourceAdminAccount(),
-			"aws_fms_policy":        fms.ResourcePolicy(),
+			"aws_fms_admin_account":        fms.ResourceAdminAccount(),
+			"aws_fms_policy":               fms.ResourcePolicy(),
+			"aws_fms_scoped_admin_account": fms.ResourceScopedAdminAccount(),
 
 			"aws_fsx_backup":                        fsx.ResourceBackup(),
 			"aws_fsx_lustre_file_system":            fsx.ResourceLustreFileSystem(),
@@ -1486,6 +1497,7 @@ func Provider() *schema.Provider {
 			"aws_grafana_workspace_saml_configuration": grafana.ResourceWorkspaceSamlConfiguration(),
 
 			"aws_guardduty_detector":                   guardduty.ResourceDetector(),
+			"aws_guardduty_detector_feature":           guardduty.ResourceDetectorFeature(),
 			"aws_guardduty_filter":                     guardduty.ResourceFilter(),
 			"aws_guardduty_invite_accepter":            guardduty.ResourceInviteAccepter(),
 			"aws_guardduty_ipset":                      guardduty.ResourceIPSet(),
@@ -1522,6 +1534,10 @@ func Provider() *schema.Provider {
 			"aws_iam_user_ssh_key":                iam.ResourceUserSSHKey(),
 			"aws_iam_virtual_mfa_device":          iam.ResourceVirtualMFADevice(),
 
+			"aws_identitystore_group":            identitystore.ResourceGroup(),
+			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_user":             identitystore.ResourceUser(),
+
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_container_recipe":             imagebuilder.ResourceContainerRecipe(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
@@ -1534,6 +1550,8 @@ func Provider() *schema.Provider {
 			"aws_inspector_assessment_template": inspector.ResourceAssessmentTemplate(),
 			"aws_inspector_resource_group":      inspector.ResourceResourceGroup(),
 
+			"aws_inspector2_delegated_admin_account": inspector2.ResourceDelegatedAdminAccount(),
+
 			"aws_iot_authorizer":                 iot.ResourceAuthorizer(),
 			"aws_iot_certificate":                iot.ResourceCertificate(),
 			"aws_iot_indexing_configuration":     iot.ResourceIndexingConfiguration(),
@@ -1576,9 +1594,12 @@ func Provider() *schema.Provider {
 			"aws_kms_replica_external_key": kms.ResourceReplicaExternalKey(),
 			"aws_kms_replica_key":          kms.ResourceReplicaKey(),
 
-			"aws_lakeformation_data_lake_settings": lakeformation.ResourceDataLakeSettings(),
-			"aws_lakeformation_permissions":        lakeformation.ResourcePermissions(),
-			"aws_lakeformation_resource":           lakeformation.ResourceResource(),
+			"aws_lakeformation_data_lake_settings":   lakeformation.ResourceDataLakeSettings(),
+			"aws_lakeformation_lf_tag":               lakeformation.ResourceLFTag(),
+			"aws_lakeformation_permissions":          lakeformation.ResourcePermissions(),
+			"aws_lakeformation_resource":             lakeformation.ResourceResource(),
+			"aws_lakeformation_resource_lf_tags":     lakeformation.ResourceResourceLFTags(),
+			"aws_lakeformation_resource_permissions": lakeformation.ResourceResourcePermissions(),
 
 			"aws_lambda_alias":                          lambda.ResourceAlias(),
 			"aws_lambda_code_signing_config":            lambda.ResourceCodeSigningConfig(),
@@ -1800,12 +1821,14 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
 			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
 			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_policy_statement":                     s3.ResourceBucketPolicyStatement(),
 			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
 			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
 			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),
 			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
 			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
 			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_directory_sync":                              s3.ResourceDirectorySync(),
 			"aws_s3_object":                                      s3.ResourceObject(),
 			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 			"aws_s3_bucket_object":                               s3.ResourceBucketObject(), // DEPRECATED: use aws_s3_object instead
@@ -1816,6 +1839,7 @@ func Provider() *schema.Provider {
 			"aws_s3control_bucket":                            s3control.ResourceBucket(),
 			"aws_s3control_bucket_lifecycle_configuration":    s3control.ResourceBucketLifecycleConfiguration(),
 			"aws_s3control_bucket_policy":                     s3control.ResourceBucketPolicy(),
+			"aws_s3control_job":                               s3control.ResourceJob(),
 			"aws_s3control_multi_region_access_point":         s3control.ResourceMultiRegionAccessPoint(),
 			"aws_s3control_multi_region_access_point_policy":  s3control.ResourceMultiRegionAccessPointPolicy(),
 			"aws_s3control_object_lambda_access_point":        s3control.ResourceObjectLambdaAccessPoint(),
@@ -1858,6 +1882,7 @@ func Provider() *schema.Provider {
 
 			"aws_securityhub_account":                    securityhub.ResourceAccount(),
 			"aws_securityhub_action_target":              securityhub.ResourceActionTarget(),
+			"aws_securityhub_automation_rule":            securityhub.ResourceAutomationRule(),
 			"aws_securityhub_insight":                    securityhub.ResourceInsight(),
 			"aws_securityhub_invite_accepter":            securityhub.ResourceInviteAccepter(),
 			"aws_securityhub_member":                     securityhub.ResourceMember(),
@@ -1908,6 +1933,7 @@ func Provider() *schema.Provider {
 			"aws_ses_template":                     ses.ResourceTemplate(),
 
 			"aws_sfn_activity":      sfn.ResourceActivity(),
+			"aws_sfn_alias":         sfn.ResourceAlias(),
 			"aws_sfn_state_machine": sfn.ResourceStateMachine(),
 
 			"aws_shield_protection":                          shield.ResourceProtection(),
@@ -1940,10 +1966,13 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_account_assignments":                ssoadmin.ResourceAccountAssignments(),
+			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                     ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":       ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_permissions_boundary_attachment":    ssoadmin.ResourcePermissionsBoundaryAttachment(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
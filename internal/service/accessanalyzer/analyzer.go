@@ -106,6 +106,10 @@ func resourceAnalyzerCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(analyzerName)
 
+	if _, err := waitAnalyzerCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for Access Analyzer Analyzer (%s) to become active: %w", d.Id(), err)
+	}
+
 	return resourceAnalyzerRead(d, meta)
 }
 
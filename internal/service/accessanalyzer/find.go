@@ -0,0 +1,34 @@
+package accessanalyzer
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAnalyzerByName(conn *accessanalyzer.AccessAnalyzer, name string) (*accessanalyzer.AnalyzerSummary, error) {
+	input := &accessanalyzer.GetAnalyzerInput{
+		AnalyzerName: aws.String(name),
+	}
+
+	output, err := conn.GetAnalyzer(input)
+
+	if tfawserr.ErrCodeEquals(err, accessanalyzer.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Analyzer == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Analyzer, nil
+}
@@ -0,0 +1,25 @@
+package accessanalyzer
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusAnalyzer fetches the Analyzer and its Status
+func statusAnalyzer(conn *accessanalyzer.AccessAnalyzer, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAnalyzerByName(conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
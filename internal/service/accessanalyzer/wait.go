@@ -0,0 +1,40 @@
+package accessanalyzer
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Maximum amount of time to wait for an Analyzer to return Active
+	// Organization analyzers scan the resources of every member account
+	// before they become active.
+	analyzerCreatedTimeout = 10 * time.Minute
+)
+
+// waitAnalyzerCreated waits for an Analyzer to return Active
+func waitAnalyzerCreated(conn *accessanalyzer.AccessAnalyzer, name string) (*accessanalyzer.AnalyzerSummary, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{accessanalyzer.AnalyzerStatusCreating},
+		Target:  []string{accessanalyzer.AnalyzerStatusActive},
+		Refresh: statusAnalyzer(conn, name),
+		Timeout: analyzerCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*accessanalyzer.AnalyzerSummary); ok {
+		if status := aws.StringValue(output.Status); status == accessanalyzer.AnalyzerStatusFailed && output.StatusReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason.Code)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
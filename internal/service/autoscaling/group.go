@@ -42,6 +42,7 @@ func ResourceGroup() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -531,6 +532,10 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"auto_rollback": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 									"checkpoint_delay": {
 										Type:         nullable.TypeNullableInt,
 										Optional:     true,
@@ -554,6 +559,10 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"skip_matching": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -566,6 +575,10 @@ func ResourceGroup() *schema.Resource {
 								ValidateDiagFunc: validateAutoScalingGroupInstanceRefreshTriggerFields,
 							},
 						},
+						"wait_for_completion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -1320,9 +1333,17 @@ func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 		if shouldRefreshInstances {
-			if err := autoScalingGroupRefreshInstances(conn, d.Id(), instanceRefresh); err != nil {
+			instanceRefreshID, err := autoScalingGroupRefreshInstances(conn, d.Id(), instanceRefresh)
+
+			if err != nil {
 				return fmt.Errorf("failed to start instance refresh of Auto Scaling Group %s: %w", d.Id(), err)
 			}
+
+			if instanceRefresh[0].(map[string]interface{})["wait_for_completion"].(bool) {
+				if _, err := waitInstanceRefreshSuccessful(conn, d.Id(), instanceRefreshID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return fmt.Errorf("error waiting for Instance Refresh (%s) of Auto Scaling Group (%s) to complete: %w", instanceRefreshID, d.Id(), err)
+				}
+			}
 		}
 	}
 
@@ -2258,6 +2279,10 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 
 	refreshPreferences := &autoscaling.RefreshPreferences{}
 
+	if v, ok := m["auto_rollback"].(bool); ok && v {
+		refreshPreferences.AutoRollback = aws.Bool(v)
+	}
+
 	if v, ok := m["checkpoint_delay"]; ok {
 		if v, null, _ := nullable.Int(v.(string)).Value(); !null {
 			refreshPreferences.CheckpointDelay = aws.Int64(v)
@@ -2282,6 +2307,10 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 		refreshPreferences.MinHealthyPercentage = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["skip_matching"].(bool); ok && v {
+		refreshPreferences.SkipMatching = aws.Bool(v)
+	}
+
 	return refreshPreferences
 }
 
@@ -2301,10 +2330,12 @@ func expandWarmPoolInstanceReusePolicy(l []interface{}) *autoscaling.InstanceReu
 	return instanceReusePolicy
 }
 
-func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, refreshConfig []interface{}) error {
+func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName string, refreshConfig []interface{}) (string, error) {
 	input := CreateGroupInstanceRefreshInput(asgName, refreshConfig)
+	var output *autoscaling.StartInstanceRefreshOutput
 	err := resource.Retry(instanceRefreshStartedTimeout, func() *resource.RetryError {
-		_, err := conn.StartInstanceRefresh(input)
+		var err error
+		output, err = conn.StartInstanceRefresh(input)
 		if tfawserr.ErrCodeEquals(err, autoscaling.ErrCodeInstanceRefreshInProgressFault) {
 			cancelErr := cancelAutoscalingInstanceRefresh(conn, asgName)
 			if cancelErr != nil {
@@ -2318,13 +2349,13 @@ func autoScalingGroupRefreshInstances(conn *autoscaling.AutoScaling, asgName str
 		return nil
 	})
 	if tfresource.TimedOut(err) {
-		_, err = conn.StartInstanceRefresh(input)
+		output, err = conn.StartInstanceRefresh(input)
 	}
 	if err != nil {
-		return fmt.Errorf("error starting Instance Refresh: %w", err)
+		return "", fmt.Errorf("error starting Instance Refresh: %w", err)
 	}
 
-	return nil
+	return aws.StringValue(output.InstanceRefreshId), nil
 }
 
 func cancelAutoscalingInstanceRefresh(conn *autoscaling.AutoScaling, asgName string) error {
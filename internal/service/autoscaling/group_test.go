@@ -1016,6 +1016,40 @@ func TestAccAutoScalingGroup_InstanceRefresh_basic(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_InstanceRefresh_waitForCompletion(t *testing.T) {
+	var group autoscaling.Group
+	resourceName := "aws_autoscaling_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_InstanceRefresh_WaitForCompletion(rName, "t3.nano"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.auto_rollback", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.skip_matching", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.wait_for_completion", "true"),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 0),
+				),
+			},
+			{
+				Config: testAccGroupConfig_InstanceRefresh_WaitForCompletion(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(resourceName, &group),
+					testAccCheckAutoScalingInstanceRefreshCount(&group, 1),
+					testAccCheckAutoScalingInstanceRefreshStatus(&group, 0, autoscaling.InstanceRefreshStatusSuccessful),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 	var group autoscaling.Group
 	resourceName := "aws_autoscaling_group.test"
@@ -4645,6 +4679,49 @@ resource "aws_launch_configuration" "test" {
 `
 }
 
+func testAccGroupConfig_InstanceRefresh_WaitForCompletion(rName, instanceType string) string {
+	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinuxHvmEbsAmi(), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.current.names[0]]
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.latest_version
+  }
+
+  instance_refresh {
+    strategy            = "Rolling"
+    wait_for_completion = true
+
+    preferences {
+      auto_rollback          = true
+      instance_warmup        = 0
+      min_healthy_percentage = 0
+      skip_matching          = true
+    }
+  }
+}
+
+data "aws_availability_zones" "current" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = %[2]q
+}
+`, rName, instanceType))
+}
+
 func testAccGroupConfig_InstanceRefresh_Start(launchConfigurationName string) string {
 	return fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
@@ -5158,6 +5235,48 @@ func TestCreateAutoScalingGroupInstanceRefreshInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "skip_matching and auto_rollback",
+			input: []interface{}{map[string]interface{}{
+				"strategy": "Rolling",
+				"preferences": []interface{}{
+					map[string]interface{}{
+						"auto_rollback":          true,
+						"skip_matching":          true,
+						"checkpoint_percentages": []interface{}{50, 100},
+					},
+				},
+			}},
+			expected: &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(asgName),
+				Strategy:             aws.String("Rolling"),
+				Preferences: &autoscaling.RefreshPreferences{
+					AutoRollback: aws.Bool(true),
+					CheckpointPercentages: []*int64{
+						aws.Int64(50),
+						aws.Int64(100),
+					},
+					SkipMatching: aws.Bool(true),
+				},
+			},
+		},
+		{
+			name: "skip_matching and auto_rollback disabled",
+			input: []interface{}{map[string]interface{}{
+				"strategy": "Rolling",
+				"preferences": []interface{}{
+					map[string]interface{}{
+						"auto_rollback": false,
+						"skip_matching": false,
+					},
+				},
+			}},
+			expected: &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(asgName),
+				Strategy:             aws.String("Rolling"),
+				Preferences:          &autoscaling.RefreshPreferences{},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1,10 +1,14 @@
 package autoscaling
 
 import (
+	"errors"
+	"log"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -15,6 +19,9 @@ const (
 
 	// Maximum amount of time to wait for an Instance Refresh to be Cancelled
 	instanceRefreshCancelledTimeout = 15 * time.Minute
+
+	// Minimum amount of time between checks of an Instance Refresh's progress
+	instanceRefreshSuccessfulMinTimeout = 15 * time.Second
 )
 
 func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instanceRefreshId string) (*autoscaling.InstanceRefresh, error) {
@@ -23,12 +30,15 @@ func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instan
 			autoscaling.InstanceRefreshStatusPending,
 			autoscaling.InstanceRefreshStatusInProgress,
 			autoscaling.InstanceRefreshStatusCancelling,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
 		},
 		Target: []string{
 			autoscaling.InstanceRefreshStatusCancelled,
-			// Failed and Successful are also acceptable end-states
+			// Failed, Successful and rollback end-states are also acceptable end-states
 			autoscaling.InstanceRefreshStatusFailed,
 			autoscaling.InstanceRefreshStatusSuccessful,
+			autoscaling.InstanceRefreshStatusRollbackFailed,
+			autoscaling.InstanceRefreshStatusRollbackSuccessful,
 		},
 		Refresh: statusInstanceRefresh(conn, asgName, instanceRefreshId),
 		Timeout: instanceRefreshCancelledTimeout,
@@ -42,3 +52,49 @@ func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instan
 
 	return nil, err
 }
+
+// waitInstanceRefreshSuccessful waits for an Instance Refresh to complete successfully, logging its progress.
+// An Instance Refresh that fails, is cancelled or is rolled back is an error.
+func waitInstanceRefreshSuccessful(conn *autoscaling.AutoScaling, asgName, instanceRefreshId string, timeout time.Duration) (*autoscaling.InstanceRefresh, error) {
+	var lastStatus string
+	var lastPercentage int64 = -1
+	refresh := statusInstanceRefresh(conn, asgName, instanceRefreshId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			autoscaling.InstanceRefreshStatusPending,
+			autoscaling.InstanceRefreshStatusInProgress,
+			autoscaling.InstanceRefreshStatusCancelling,
+			autoscaling.InstanceRefreshStatusRollbackInProgress,
+		},
+		Target: []string{
+			autoscaling.InstanceRefreshStatusSuccessful,
+		},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+				if percentage := aws.Int64Value(v.PercentageComplete); status != lastStatus || percentage != lastPercentage {
+					log.Printf("[INFO] Auto Scaling Group (%s) Instance Refresh (%s) %s: %d%% complete, %d instances to update", asgName, instanceRefreshId, status, percentage, aws.Int64Value(v.InstancesToUpdate))
+					lastStatus, lastPercentage = status, percentage
+				}
+			}
+
+			return outputRaw, status, err
+		},
+		Timeout:    timeout,
+		MinTimeout: instanceRefreshSuccessfulMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*autoscaling.InstanceRefresh); ok {
+		if reason := aws.StringValue(v.StatusReason); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+
+		return v, err
+	}
+
+	return nil, err
+}
@@ -85,3 +85,24 @@ func FindJobDefinition(conn *batch.Batch, input *batch.DescribeJobDefinitionsInp
 
 	return output.JobDefinitions[0], nil
 }
+
+func FindJobDetailByID(conn *batch.Batch, id string) (*batch.JobDetail, error) {
+	input := &batch.DescribeJobsInput{
+		Jobs: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeJobs(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Jobs) == 0 || output.Jobs[0] == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.Jobs[0], nil
+}
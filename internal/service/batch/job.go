@@ -0,0 +1,529 @@
+package batch
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobCreate,
+		Read:   resourceJobRead,
+		Update: resourceJobUpdate,
+		Delete: resourceJobDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"array_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(2, 10000),
+						},
+					},
+				},
+				ConflictsWith: []string{"node_overrides"},
+			},
+			"container_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     jobContainerOverridesSchema(),
+			},
+			"exit_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fail_on_failure": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"wait_for_completion"},
+			},
+			"job_definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_queue": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"log_stream_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"node_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_property_override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"container_overrides": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem:     jobContainerOverridesSchema(),
+									},
+									"target_nodes": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"num_nodes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+				ConflictsWith: []string{"array_properties", "container_overrides"},
+			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"propagate_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"retry_strategy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"timeout": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attempt_duration_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func jobContainerOverridesSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"command": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"environment": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resource_requirement": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(batch.ResourceType_Values(), false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	name := d.Get("name").(string)
+
+	input := &batch.SubmitJobInput{
+		JobDefinition: aws.String(d.Get("job_definition").(string)),
+		JobName:       aws.String(name),
+		JobQueue:      aws.String(d.Get("job_queue").(string)),
+		PropagateTags: aws.Bool(d.Get("propagate_tags").(bool)),
+	}
+
+	if v, ok := d.GetOk("array_properties"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ArrayProperties = expandBatchArrayProperties(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("container_overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ContainerOverrides = expandBatchContainerOverrides(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("node_overrides"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.NodeOverrides = expandBatchNodeOverrides(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		input.Parameters = expandJobDefinitionParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("retry_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.RetryStrategy = expandBatchRetryStrategy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("timeout"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Timeout = expandBatchJobTimeout(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Submitting Batch Job: %s", input)
+	output, err := conn.SubmitJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error submitting Batch Job (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.JobId))
+
+	if !d.Get("wait_for_completion").(bool) {
+		return resourceJobRead(d, meta)
+	}
+
+	job, err := waitJobCompleted(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Batch Job (%s) to complete: %w", d.Id(), err)
+	}
+
+	// Record the job's result, e.g. its exit code, before reporting a failure.
+	if err := resourceJobRead(d, meta); err != nil {
+		return err
+	}
+
+	if status := aws.StringValue(job.Status); status == batch.JobStatusFailed && d.Get("fail_on_failure").(bool) {
+		return fmt.Errorf("error running Batch Job (%s): job %s: %s", d.Id(), status, aws.StringValue(job.StatusReason))
+	}
+
+	return nil
+}
+
+func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	job, err := FindJobDetailByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// AWS Batch only retains finished jobs for a limited time.
+		// Removing the resource from state would submit the job again, so keep the last known state.
+		log.Printf("[WARN] Batch Job (%s) not found, keeping last known state", d.Id())
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Batch Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", job.JobArn)
+	d.Set("name", job.JobName)
+	d.Set("status", job.Status)
+	d.Set("status_reason", job.StatusReason)
+
+	container := job.Container
+
+	// The container of a multi-node parallel job is reported on the main node's child job.
+	if container == nil && job.NodeProperties != nil && job.NodeProperties.MainNode != nil {
+		mainNodeID := fmt.Sprintf("%s#%d", d.Id(), aws.Int64Value(job.NodeProperties.MainNode))
+		mainNode, err := FindJobDetailByID(conn, mainNodeID)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return fmt.Errorf("error reading Batch Job (%s): %w", mainNodeID, err)
+		default:
+			container = mainNode.Container
+		}
+	}
+
+	if container != nil {
+		d.Set("exit_code", container.ExitCode)
+		d.Set("log_stream_name", container.LogStreamName)
+	} else {
+		d.Set("exit_code", nil)
+		d.Set("log_stream_name", nil)
+	}
+
+	tags := KeyValueTags(job.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating tags: %w", err)
+		}
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BatchConn
+
+	job, err := FindJobDetailByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Batch Job (%s): %w", d.Id(), err)
+	}
+
+	switch aws.StringValue(job.Status) {
+	case batch.JobStatusSucceeded, batch.JobStatusFailed:
+		return nil
+	}
+
+	log.Printf("[DEBUG] Terminating Batch Job: %s", d.Id())
+	_, err = conn.TerminateJob(&batch.TerminateJobInput{
+		JobId:  aws.String(d.Id()),
+		Reason: aws.String("Terminated by Terraform"),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error terminating Batch Job (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitJobCompleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for Batch Job (%s) to terminate: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandBatchArrayProperties(tfMap map[string]interface{}) *batch.ArrayProperties {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.ArrayProperties{}
+
+	if v, ok := tfMap["size"].(int); ok && v != 0 {
+		apiObject.Size = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandBatchContainerOverrides(tfMap map[string]interface{}) *batch.ContainerOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.ContainerOverrides{}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		for name, value := range v {
+			apiObject.Environment = append(apiObject.Environment, &batch.KeyValuePair{
+				Name:  aws.String(name),
+				Value: aws.String(value.(string)),
+			})
+		}
+	}
+
+	if v, ok := tfMap["instance_type"].(string); ok && v != "" {
+		apiObject.InstanceType = aws.String(v)
+	}
+
+	if v, ok := tfMap["resource_requirement"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRequirements = expandBatchResourceRequirements(v.List())
+	}
+
+	return apiObject
+}
+
+func expandBatchResourceRequirements(tfList []interface{}) []*batch.ResourceRequirement {
+	var apiObjects []*batch.ResourceRequirement
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &batch.ResourceRequirement{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandBatchNodeOverrides(tfMap map[string]interface{}) *batch.NodeOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &batch.NodeOverrides{}
+
+	if v, ok := tfMap["node_property_override"].([]interface{}); ok && len(v) > 0 {
+		apiObject.NodePropertyOverrides = expandBatchNodePropertyOverrides(v)
+	}
+
+	if v, ok := tfMap["num_nodes"].(int); ok && v != 0 {
+		apiObject.NumNodes = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandBatchNodePropertyOverrides(tfList []interface{}) []*batch.NodePropertyOverride {
+	var apiObjects []*batch.NodePropertyOverride
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &batch.NodePropertyOverride{
+			TargetNodes: aws.String(tfMap["target_nodes"].(string)),
+		}
+
+		if v, ok := tfMap["container_overrides"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ContainerOverrides = expandBatchContainerOverrides(v[0].(map[string]interface{}))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
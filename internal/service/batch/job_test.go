@@ -0,0 +1,553 @@
+package batch_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbatch "github.com/hashicorp/terraform-provider-aws/internal/service/batch"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccBatchJob_basic(t *testing.T) {
+	var job batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "batch", regexp.MustCompile(`job/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "job_definition", "aws_batch_job_definition.test", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_queue", "aws_batch_job_queue.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_waitForCompletion(t *testing.T) {
+	var job batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobWaitForCompletionConfig(rName, "0"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "exit_code", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_stream_name"),
+					resource.TestCheckResourceAttr(resourceName, "status", batch.JobStatusSucceeded),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_failed(t *testing.T) {
+	var job batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobWaitForCompletionConfig(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "exit_code", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_failure", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "log_stream_name"),
+					resource.TestCheckResourceAttr(resourceName, "status", batch.JobStatusFailed),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_failOnFailure(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobFailOnFailureConfig(rName, "1"),
+				ExpectError: regexp.MustCompile(`job FAILED`),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_arrayProperties(t *testing.T) {
+	var job batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobArrayPropertiesConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "array_properties.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "array_properties.0.size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", batch.JobStatusSucceeded),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_triggers(t *testing.T) {
+	var job1, job2 batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTriggersConfig(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "1"),
+				),
+			},
+			{
+				Config: testAccJobTriggersConfig(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job2),
+					testAccCheckJobRecreated(&job1, &job2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBatchJob_tags(t *testing.T) {
+	var job batch.JobDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, batch.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobTags1Config(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccJobTags2Config(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &job),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobExists(n string, v *batch.JobDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Batch Job ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
+
+		output, err := tfbatch.FindJobDetailByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).BatchConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_batch_job" {
+			continue
+		}
+
+		output, err := tfbatch.FindJobDetailByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Jobs are retained after completion, so check that the job has finished.
+		switch status := aws.StringValue(output.Status); status {
+		case batch.JobStatusSucceeded, batch.JobStatusFailed:
+		default:
+			return fmt.Errorf("Batch Job %s still %s", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckJobRecreated(i, j *batch.JobDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.JobId) == aws.StringValue(j.JobId) {
+			return fmt.Errorf("Batch Job not resubmitted")
+		}
+
+		return nil
+	}
+}
+
+func testAccJobBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "batch_service" {
+  name = "%[1]s_batch_service"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "batch.${data.aws_partition.current.dns_suffix}"
+    }
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "batch_service" {
+  role       = aws_iam_role.batch_service.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSBatchServiceRole"
+}
+
+resource "aws_iam_role" "ecs_task_execution" {
+  name = "%[1]s_ecs_task_execution"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Action": "sts:AssumeRole",
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "ecs-tasks.${data.aws_partition.current.dns_suffix}"
+    }
+  }]
+}
+EOF
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_task_execution" {
+  role       = aws_iam_role.ecs_task_execution.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AmazonECSTaskExecutionRolePolicy"
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = aws_internet_gateway.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table_association" "test" {
+  route_table_id = aws_route_table.test.id
+  subnet_id      = aws_subnet.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_batch_compute_environment" "test" {
+  compute_environment_name = %[1]q
+
+  compute_resources {
+    max_vcpus          = 4
+    security_group_ids = [aws_security_group.test.id]
+    subnets            = [aws_subnet.test.id]
+    type               = "FARGATE"
+  }
+
+  service_role = aws_iam_role.batch_service.arn
+  type         = "MANAGED"
+  depends_on   = [aws_iam_role_policy_attachment.batch_service, aws_route_table_association.test]
+}
+
+resource "aws_batch_job_queue" "test" {
+  compute_environments = [aws_batch_compute_environment.test.arn]
+  name                 = %[1]q
+  priority             = 1
+  state                = "ENABLED"
+}
+
+resource "aws_batch_job_definition" "test" {
+  name                  = %[1]q
+  type                  = "container"
+  platform_capabilities = ["FARGATE"]
+
+  container_properties = jsonencode({
+    command          = ["sh", "-c", "exit Ref::exit_code"]
+    image            = "public.ecr.aws/amazonlinux/amazonlinux:latest"
+    executionRoleArn = aws_iam_role.ecs_task_execution.arn
+
+    networkConfiguration = {
+      assignPublicIp = "ENABLED"
+    }
+
+    resourceRequirements = [
+      {
+        type  = "VCPU"
+        value = "0.25"
+      },
+      {
+        type  = "MEMORY"
+        value = "512"
+      },
+    ]
+  })
+
+  parameters = {
+    exit_code = "0"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.ecs_task_execution]
+}
+`, rName))
+}
+
+func testAccJobConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+}
+`, rName))
+}
+
+func testAccJobWaitForCompletionConfig(rName, exitCode string) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  parameters = {
+    exit_code = %[2]q
+  }
+
+  container_overrides {
+    environment = {
+      JOB_NAME = %[1]q
+    }
+  }
+
+  wait_for_completion = true
+}
+`, rName, exitCode))
+}
+
+func testAccJobFailOnFailureConfig(rName, exitCode string) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  parameters = {
+    exit_code = %[2]q
+  }
+
+  wait_for_completion = true
+  fail_on_failure     = true
+}
+`, rName, exitCode))
+}
+
+func testAccJobArrayPropertiesConfig(rName string, size int) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  array_properties {
+    size = %[2]d
+  }
+
+  wait_for_completion = true
+}
+`, rName, size))
+}
+
+func testAccJobTriggersConfig(rName, version string) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  triggers = {
+    version = %[2]q
+  }
+}
+`, rName, version))
+}
+
+func testAccJobTags1Config(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccJobTags2Config(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccJobBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_batch_job" "test" {
+  name           = %[1]q
+  job_definition = aws_batch_job_definition.test.arn
+  job_queue      = aws_batch_job_queue.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -22,3 +22,19 @@ func statusComputeEnvironment(conn *batch.Batch, name string) resource.StateRefr
 		return computeEnvironmentDetail, aws.StringValue(computeEnvironmentDetail.Status), nil
 	}
 }
+
+func statusJob(conn *batch.Batch, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		jobDetail, err := FindJobDetailByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return jobDetail, aws.StringValue(jobDetail.Status), nil
+	}
+}
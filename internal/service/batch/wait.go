@@ -10,6 +10,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+const (
+	// Minimum amount of time between checks of a job's status
+	jobCompletedMinTimeout = 15 * time.Second
+)
+
 func waitComputeEnvironmentCreated(conn *batch.Batch, name string, timeout time.Duration) (*batch.ComputeEnvironmentDetail, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{batch.CEStatusCreating},
@@ -89,3 +94,22 @@ func waitComputeEnvironmentUpdated(conn *batch.Batch, name string, timeout time.
 
 	return nil, err
 }
+
+// waitJobCompleted waits for a job to finish, either succeeding or failing.
+func waitJobCompleted(conn *batch.Batch, id string, timeout time.Duration) (*batch.JobDetail, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{batch.JobStatusSubmitted, batch.JobStatusPending, batch.JobStatusRunnable, batch.JobStatusStarting, batch.JobStatusRunning},
+		Target:     []string{batch.JobStatusSucceeded, batch.JobStatusFailed},
+		Refresh:    statusJob(conn, id),
+		Timeout:    timeout,
+		MinTimeout: jobCompletedMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*batch.JobDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -20,6 +20,10 @@ func TestAccDetective_serial(t *testing.T) {
 			"disappear": testAccDetectiveMember_disappears,
 			"message":   testAccDetectiveMember_message,
 		},
+		"OrganizationAdminAccount": {
+			"basic":      testAccDetectiveOrganizationAdminAccount_basic,
+			"disappears": testAccDetectiveOrganizationAdminAccount_disappears,
+		},
 	}
 
 	for group, m := range testCases {
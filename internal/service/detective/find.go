@@ -129,3 +129,45 @@ func FindMemberByGraphArnAndAccountID(ctx context.Context, conn *detective.Detec
 
 	return result, nil
 }
+
+func FindOrganizationAdminAccountByAccountID(ctx context.Context, conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	input := &detective.ListOrganizationAdminAccountsInput{}
+	var result *detective.Administrator
+
+	err := conn.ListOrganizationAdminAccountsPagesWithContext(ctx, input, func(page *detective.ListOrganizationAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, administrator := range page.Administrators {
+			if administrator == nil {
+				continue
+			}
+
+			if aws.StringValue(administrator.AccountId) == accountID {
+				result = administrator
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, &resource.NotFoundError{
+			Message:     fmt.Sprintf("No organization admin account found with accountID %q", accountID),
+			LastRequest: input,
+		}
+	}
+
+	return result, nil
+}
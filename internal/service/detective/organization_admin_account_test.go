@@ -0,0 +1,134 @@
+package detective_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccDetectiveOrganizationAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_detective_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, detective.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectiveOrganizationAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectiveOrganizationAdminAccountConfigSelf(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectiveOrganizationAdminAccountExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "graph_arn", "detective", regexp.MustCompile(`graph:.+`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDetectiveOrganizationAdminAccount_disappears(t *testing.T) {
+	resourceName := "aws_detective_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, detective.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectiveOrganizationAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDetectiveOrganizationAdminAccountConfigSelf(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectiveOrganizationAdminAccountExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdetective.ResourceOrganizationAdminAccount(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDetectiveOrganizationAdminAccountDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_detective_organization_admin_account" {
+			continue
+		}
+
+		_, err := tfdetective.FindOrganizationAdminAccountByAccountID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		// Because of this resource's dependency, the Organizations organization
+		// will be deleted first, resulting in the following valid error
+		if tfawserr.ErrMessageContains(err, detective.ErrCodeAccessDeniedException, "account is not a member of an organization") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("expected Detective Organization Admin Account (%s) to be removed", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckDetectiveOrganizationAdminAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn
+
+		_, err := tfdetective.FindOrganizationAdminAccountByAccountID(context.Background(), conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccDetectiveOrganizationAdminAccountConfigSelf() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["detective.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_detective_organization_admin_account" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  account_id = data.aws_caller_identity.current.account_id
+}
+`
+}
@@ -6,6 +6,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// Detective does not report a status for organization admin accounts, only whether they are listed.
+	organizationAdminAccountStatusEnabled = "Enabled"
 )
 
 // MemberStatus fetches the Member and its status
@@ -24,3 +30,20 @@ func MemberStatus(ctx context.Context, conn *detective.Detective, graphARN, admi
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+// OrganizationAdminAccountStatus fetches the organization admin account.
+func OrganizationAdminAccountStatus(ctx context.Context, conn *detective.Detective, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindOrganizationAdminAccountByAccountID(ctx, conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, organizationAdminAccountStatusEnabled, nil
+	}
+}
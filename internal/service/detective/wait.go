@@ -13,6 +13,8 @@ const (
 	GraphOperationTimeout = 4 * time.Minute
 	// MemberStatusPropagationTimeout Maximum amount of time to wait for a detective member status to return Invited
 	MemberStatusPropagationTimeout = 4 * time.Minute
+	// OrganizationAdminAccountTimeout Maximum amount of time to wait for a detective organization admin account to be enabled, disabled
+	OrganizationAdminAccountTimeout = 5 * time.Minute
 )
 
 // MemberStatusUpdated waits for an AdminAccount and graph arn to return Invited
@@ -32,3 +34,36 @@ func MemberStatusUpdated(ctx context.Context, conn *detective.Detective, graphAR
 
 	return nil, err
 }
+
+// OrganizationAdminAccountEnabled waits for an organization admin account to be listed
+func OrganizationAdminAccountEnabled(ctx context.Context, conn *detective.Detective, accountID string) (*detective.Administrator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{organizationAdminAccountStatusEnabled},
+		Refresh:                   OrganizationAdminAccountStatus(ctx, conn, accountID),
+		Timeout:                   OrganizationAdminAccountTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*detective.Administrator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// OrganizationAdminAccountNotFound waits for an organization admin account to no longer be listed
+func OrganizationAdminAccountNotFound(ctx context.Context, conn *detective.Detective, accountID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{organizationAdminAccountStatusEnabled},
+		Target:  []string{},
+		Refresh: OrganizationAdminAccountStatus(ctx, conn, accountID),
+		Timeout: OrganizationAdminAccountTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
@@ -0,0 +1,200 @@
+package ec2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// userDataMaxSize is the maximum size of EC2 instance user data, before it is base64-encoded.
+	userDataMaxSize = 16384
+
+	cloudInitConfigDefaultBoundary    = "MIMEBOUNDARY"
+	cloudInitConfigDefaultContentType = "text/plain"
+)
+
+func DataSourceCloudInitConfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCloudInitConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"base64_encode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"boundary": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  cloudInitConfigDefaultBoundary,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 70),
+					validation.StringDoesNotContainAny(`"`),
+				),
+			},
+			"gzip": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"part": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudInitConfigDefaultContentType,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"filename": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"merge_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"rendered": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"user_data_hash": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCloudInitConfigRead(d *schema.ResourceData, meta interface{}) error {
+	base64Encode := d.Get("base64_encode").(bool)
+	gzipOutput := d.Get("gzip").(bool)
+
+	// Compressed output is binary and can only be passed around as a base64-encoded string.
+	if gzipOutput && !base64Encode {
+		return fmt.Errorf(`"base64_encode" must be true when "gzip" is true`)
+	}
+
+	output, err := renderCloudInitConfig(d.Get("boundary").(string), gzipOutput, d.Get("part").([]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("error rendering cloud-init config: %w", err)
+	}
+
+	if n := len(output); n > userDataMaxSize {
+		return fmt.Errorf("rendered cloud-init config is %d bytes, which exceeds the EC2 user data limit of %d bytes", n, userDataMaxSize)
+	}
+
+	rendered := string(output)
+
+	if base64Encode {
+		rendered = base64.StdEncoding.EncodeToString(output)
+	}
+
+	// The hash is that of the unencoded user data, the value stored by aws_instance for user_data.
+	hash := sha1.Sum(output)
+	userDataHash := hex.EncodeToString(hash[:])
+
+	d.SetId(userDataHash)
+	d.Set("rendered", rendered)
+	d.Set("size", len(output))
+	d.Set("user_data_hash", userDataHash)
+
+	return nil
+}
+
+// renderCloudInitConfig builds a MIME multi-part cloud-init document from the specified parts, optionally gzip-compressed.
+// The output only depends on the inputs so that unchanged parts render identical user data.
+func renderCloudInitConfig(boundary string, gzipOutput bool, tfList []interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n", boundary)
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n\r\n")
+
+	w := multipart.NewWriter(&buf)
+
+	if err := w.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Transfer-Encoding", "7bit")
+		header.Set("Content-Type", tfMap["content_type"].(string))
+		header.Set("Mime-Version", "1.0")
+
+		if v, ok := tfMap["filename"].(string); ok && v != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", v))
+		}
+
+		if v, ok := tfMap["merge_type"].(string); ok && v != "" {
+			header.Set("X-Merge-Type", v)
+		}
+
+		part, err := w.CreatePart(header)
+
+		if err != nil {
+			return nil, fmt.Errorf("part %d: %w", i, err)
+		}
+
+		if _, err := part.Write([]byte(tfMap["content"].(string))); err != nil {
+			return nil, fmt.Errorf("part %d: %w", i, err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	if !gzipOutput {
+		return buf.Bytes(), nil
+	}
+
+	var gzipBuf bytes.Buffer
+
+	// The gzip header is left without a modification time or name so that the output is reproducible.
+	gw, err := gzip.NewWriterLevel(&gzipBuf, gzip.BestCompression)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := gw.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return gzipBuf.Bytes(), nil
+}
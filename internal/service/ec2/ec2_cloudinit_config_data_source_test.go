@@ -0,0 +1,146 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CloudInitConfigDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ec2_cloudinit_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudInitConfigDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "part.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "rendered", "Content-Type: multipart/mixed; boundary=\"MIMEBOUNDARY\"\r\nMIME-Version: 1.0\r\n\r\n"+
+						"--MIMEBOUNDARY\r\nContent-Disposition: attachment; filename=\"hello.sh\"\r\nContent-Transfer-Encoding: 7bit\r\nContent-Type: text/x-shellscript\r\nMime-Version: 1.0\r\n\r\n#!/bin/bash\necho hello\n\r\n"+
+						"--MIMEBOUNDARY\r\nContent-Transfer-Encoding: 7bit\r\nContent-Type: text/cloud-config\r\nMime-Version: 1.0\r\nX-Merge-Type: list(append)+dict(recurse_array)+str()\r\n\r\n#cloud-config\n\r\n"+
+						"--MIMEBOUNDARY--\r\n"),
+					resource.TestCheckResourceAttr(dataSourceName, "size", "451"),
+					resource.TestMatchResourceAttr(dataSourceName, "user_data_hash", regexp.MustCompile(`^[0-9a-f]{40}$`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", dataSourceName, "user_data_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CloudInitConfigDataSource_gzip(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_cloudinit_config.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudInitConfigDataSourceConfig_gzip(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "rendered", regexp.MustCompile(`^H4sI`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "size"),
+					resource.TestCheckResourceAttrPair(resourceName, "user_data", dataSourceName, "rendered"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2CloudInitConfigDataSource_tooLarge(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudInitConfigDataSourceConfig_tooLarge,
+				ExpectError: regexp.MustCompile(`exceeds the EC2 user data limit of 16384 bytes`),
+			},
+		},
+	})
+}
+
+func TestAccEC2CloudInitConfigDataSource_gzipWithoutBase64(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudInitConfigDataSourceConfig_gzipWithoutBase64,
+				ExpectError: regexp.MustCompile(`"base64_encode" must be true when "gzip" is true`),
+			},
+		},
+	})
+}
+
+const testAccCloudInitConfigDataSourceConfig_basic = `
+data "aws_ec2_cloudinit_config" "test" {
+  base64_encode = false
+  gzip          = false
+
+  part {
+    content_type = "text/x-shellscript"
+    filename     = "hello.sh"
+    content      = "#!/bin/bash\necho hello\n"
+  }
+
+  part {
+    content_type = "text/cloud-config"
+    merge_type   = "list(append)+dict(recurse_array)+str()"
+    content      = "#cloud-config\n"
+  }
+}
+`
+
+func testAccCloudInitConfigDataSourceConfig_gzip(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_cloudinit_config" "test" {
+  part {
+    content_type = "text/cloud-config"
+    content = yamlencode({
+      packages = ["nginx"]
+    })
+  }
+}
+
+resource "aws_launch_template" "test" {
+  name      = %[1]q
+  user_data = data.aws_ec2_cloudinit_config.test.rendered
+}
+`, rName)
+}
+
+const testAccCloudInitConfigDataSourceConfig_tooLarge = `
+data "aws_ec2_cloudinit_config" "test" {
+  base64_encode = false
+  gzip          = false
+
+  part {
+    content = join("", [for i in range(2000) : "0123456789"])
+  }
+}
+`
+
+const testAccCloudInitConfigDataSourceConfig_gzipWithoutBase64 = `
+data "aws_ec2_cloudinit_config" "test" {
+  base64_encode = false
+
+  part {
+    content = "#cloud-config\n"
+  }
+}
+`
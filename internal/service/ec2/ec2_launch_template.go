@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -633,12 +634,24 @@ func ResourceLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"retained_versions": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntAtLeast(1)},
+				RequiredWith: []string{"version_retention"},
+			},
 			"security_group_names": {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"vpc_security_group_ids"},
 			},
+			"set_default_version": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"update_default_version"},
+				ValidateFunc:  validation.StringInSlice(LaunchTemplateSetDefaultVersion_Values(), false),
+			},
 			"tag_specifications": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -658,12 +671,17 @@ func ResourceLaunchTemplate() *schema.Resource {
 			"update_default_version": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"default_version"},
+				ConflictsWith: []string{"default_version", "set_default_version"},
 			},
 			"user_data": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"version_retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"vpc_security_group_ids": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -675,13 +693,24 @@ func ResourceLaunchTemplate() *schema.Resource {
 		// Enable downstream updates for resources referencing schema attributes
 		// to prevent non-empty plans after "terraform apply"
 		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Get("set_default_version").(string) != LaunchTemplateSetDefaultVersionLatest {
+					return nil
+				}
+
+				if v := diff.GetRawConfig(); v.IsKnown() && !v.IsNull() && !v.GetAttr("default_version").IsNull() {
+					return fmt.Errorf(`"default_version" cannot be specified when "set_default_version" is %q`, LaunchTemplateSetDefaultVersionLatest)
+				}
+
+				return nil
+			},
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description":
+					case "name", "name_prefix", "description", "retained_versions", "version_retention":
 						continue
 					default:
-						return diff.Get("update_default_version").(bool)
+						return launchTemplateUpdateDefaultVersion(diff)
 					}
 				}
 				return false
@@ -689,7 +718,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "update_default_version":
+					case "name", "name_prefix", "description", "default_version", "retained_versions", "set_default_version", "update_default_version", "version_retention":
 						continue
 					default:
 						return true
@@ -844,12 +873,12 @@ func resourceLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	}
 
-	if d.Get("update_default_version").(bool) || d.HasChange("default_version") {
+	if launchTemplateUpdateDefaultVersion(d) || d.HasChange("default_version") {
 		input := &ec2.ModifyLaunchTemplateInput{
 			LaunchTemplateId: aws.String(d.Id()),
 		}
 
-		if d.Get("update_default_version").(bool) {
+		if launchTemplateUpdateDefaultVersion(d) {
 			input.DefaultVersion = aws.String(strconv.FormatInt(latestVersion, 10))
 		} else if d.HasChange("default_version") {
 			input.DefaultVersion = aws.String(strconv.Itoa(d.Get("default_version").(int)))
@@ -862,6 +891,18 @@ func resourceLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("version_retention"); ok && (d.HasChanges(updateKeys...) || d.HasChanges("default_version", "retained_versions", "version_retention")) {
+		var keep []int64
+
+		for _, v := range d.Get("retained_versions").(*schema.Set).List() {
+			keep = append(keep, int64(v.(int)))
+		}
+
+		if err := pruneLaunchTemplateVersions(conn, d.Id(), v.(int), keep); err != nil {
+			return fmt.Errorf("error pruning EC2 Launch Template (%s) versions: %w", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
@@ -873,6 +914,74 @@ func resourceLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) erro
 	return resourceLaunchTemplateRead(d, meta)
 }
 
+// launchTemplateUpdateDefaultVersion returns whether each new version becomes the default version.
+func launchTemplateUpdateDefaultVersion(d interface{ Get(string) interface{} }) bool {
+	return d.Get("update_default_version").(bool) || d.Get("set_default_version").(string) == LaunchTemplateSetDefaultVersionLatest
+}
+
+// pruneLaunchTemplateVersions deletes all but the specified number of most recent versions of a launch template.
+// The default version and the versions in keep are never deleted.
+// Versions referenced by other resources (e.g. Auto Scaling groups or EKS node groups) are not detected
+// and must be listed in keep to be retained.
+func pruneLaunchTemplateVersions(conn *ec2.EC2, id string, retain int, keep []int64) error {
+	versions, err := FindLaunchTemplateVersions(conn, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return aws.Int64Value(versions[i].VersionNumber) > aws.Int64Value(versions[j].VersionNumber)
+	})
+
+	retained := make(map[int64]bool, len(keep))
+
+	for _, v := range keep {
+		retained[v] = true
+	}
+
+	var prune []string
+
+	for i, v := range versions {
+		if i < retain || aws.BoolValue(v.DefaultVersion) || retained[aws.Int64Value(v.VersionNumber)] {
+			continue
+		}
+
+		prune = append(prune, strconv.FormatInt(aws.Int64Value(v.VersionNumber), 10))
+	}
+
+	// A maximum of 200 versions can be deleted in a single request.
+	const batchSize = 200
+
+	for len(prune) > 0 {
+		n := len(prune)
+
+		if n > batchSize {
+			n = batchSize
+		}
+
+		log.Printf("[DEBUG] Deleting EC2 Launch Template (%s) versions: %s", id, prune[:n])
+		output, err := conn.DeleteLaunchTemplateVersions(&ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(id),
+			Versions:         aws.StringSlice(prune[:n]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if err := DeleteLaunchTemplateVersionsErrors(output.UnsuccessfullyDeletedLaunchTemplateVersions); err != nil {
+			return err
+		}
+
+		prune = prune[n:]
+	}
+
+	return nil
+}
+
 func resourceLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
@@ -2,9 +2,12 @@ package ec2_test
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -1568,6 +1571,126 @@ func TestAccEC2LaunchTemplate_updateDefaultVersion(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_setDefaultVersion(t *testing.T) {
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_descriptionSetDefaultVersion(rName, "Test Description 1", tfec2.LaunchTemplateSetDefaultVersionExplicit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "set_default_version", tfec2.LaunchTemplateSetDefaultVersionExplicit),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionSetDefaultVersion(rName, "Test Description 2", tfec2.LaunchTemplateSetDefaultVersionExplicit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionSetDefaultVersion(rName, "Test Description 3", tfec2.LaunchTemplateSetDefaultVersionLatest),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "set_default_version", tfec2.LaunchTemplateSetDefaultVersionLatest),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"set_default_version",
+				},
+			},
+			{
+				Config:      testAccLaunchTemplateConfig_setDefaultVersionLatestWithDefaultVersion(rName),
+				ExpectError: regexp.MustCompile(`"default_version" cannot be specified when "set_default_version" is "latest"`),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_versionRetention(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersion(rName, "Test Description 1", 1),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersion(rName, "Test Description 2", 1),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersion(rName, "Test Description 3", 1),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersion(rName, "Test Description 4", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					testAccCheckLaunchTemplateVersionNumbers(resourceName, 1, 2, 3, 4),
+				),
+			},
+			// Setting a retention prunes all but the most recent versions and the default version.
+			{
+				Config: testAccLaunchTemplateConfig_descriptionVersionRetention(rName, "Test Description 4", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					testAccCheckLaunchTemplateVersionNumbers(resourceName, 1, 3, 4),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "4"),
+					resource.TestCheckResourceAttr(resourceName, "version_retention", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionVersionRetention(rName, "Test Description 5", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					testAccCheckLaunchTemplateVersionNumbers(resourceName, 1, 4, 5),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "5"),
+				),
+			},
+			// Versions listed in retained_versions are kept outside of the retention window.
+			{
+				Config: testAccLaunchTemplateConfig_descriptionVersionRetentionRetainedVersions(rName, "Test Description 6", 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					testAccCheckLaunchTemplateVersionNumbers(resourceName, 1, 4, 5, 6),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "6"),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "retained_versions.*", "4"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionVersionRetention(rName, "Test Description 7", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(resourceName, &template),
+					testAccCheckLaunchTemplateVersionNumbers(resourceName, 1, 6, 7),
+					resource.TestCheckResourceAttr(resourceName, "retained_versions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLaunchTemplateExists(n string, v *ec2.LaunchTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1593,6 +1716,39 @@ func testAccCheckLaunchTemplateExists(n string, v *ec2.LaunchTemplate) resource.
 	}
 }
 
+func testAccCheckLaunchTemplateVersionNumbers(n string, expected ...int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		versions, err := tfec2.FindLaunchTemplateVersions(conn, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var actual []int64
+
+		for _, v := range versions {
+			actual = append(actual, aws.Int64Value(v.VersionNumber))
+		}
+
+		sort.Slice(actual, func(i, j int) bool { return actual[i] < actual[j] })
+
+		if !reflect.DeepEqual(actual, expected) {
+			return fmt.Errorf("EC2 Launch Template %s versions: expected %v, got %v", rs.Primary.ID, expected, actual)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckLaunchTemplateDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -2534,3 +2690,46 @@ resource "aws_launch_template" "test" {
 }
 `, rName, description, update)
 }
+
+func testAccLaunchTemplateConfig_descriptionSetDefaultVersion(rName, description, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                = %[1]q
+  description         = %[2]q
+  set_default_version = %[3]q
+}
+`, rName, description, mode)
+}
+
+func testAccLaunchTemplateConfig_setDefaultVersionLatestWithDefaultVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                = %[1]q
+  default_version     = 1
+  set_default_version = "latest"
+}
+`, rName)
+}
+
+func testAccLaunchTemplateConfig_descriptionVersionRetention(rName, description string, retention int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name              = %[1]q
+  description       = %[2]q
+  default_version   = 1
+  version_retention = %[3]d
+}
+`, rName, description, retention)
+}
+
+func testAccLaunchTemplateConfig_descriptionVersionRetentionRetainedVersions(rName, description string, retention, retainedVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name              = %[1]q
+  description       = %[2]q
+  default_version   = 1
+  version_retention = %[3]d
+  retained_versions = [%[4]d]
+}
+`, rName, description, retention, retainedVersion)
+}
@@ -0,0 +1,226 @@
+package ec2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceLaunchTemplateVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLaunchTemplateVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"default_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"launch_template_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"launch_template_id", "launch_template_name"},
+			},
+			"launch_template_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"launch_template_id", "launch_template_name"},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"changed_attributes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_version": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"launch_template_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLaunchTemplateVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.DescribeLaunchTemplatesInput{}
+
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		input.LaunchTemplateIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	if v, ok := d.GetOk("launch_template_name"); ok {
+		input.LaunchTemplateNames = aws.StringSlice([]string{v.(string)})
+	}
+
+	lt, err := FindLaunchTemplate(conn, input)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("EC2 Launch Template", err)
+	}
+
+	id := aws.StringValue(lt.LaunchTemplateId)
+	versions, err := FindLaunchTemplateVersions(conn, &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Launch Template (%s) versions: %w", id, err)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return aws.Int64Value(versions[i].VersionNumber) < aws.Int64Value(versions[j].VersionNumber)
+	})
+
+	tfList := make([]interface{}, 0, len(versions))
+
+	for i, v := range versions {
+		data, err := jsonutil.BuildJSON(v.LaunchTemplateData)
+
+		if err != nil {
+			return fmt.Errorf("error converting EC2 Launch Template (%s) version (%d) data to JSON: %w", id, aws.Int64Value(v.VersionNumber), err)
+		}
+
+		// The first available version has nothing to be compared with.
+		var changes []string
+
+		if i > 0 {
+			changes = launchTemplateDataChangedAttributes(versions[i-1].LaunchTemplateData, v.LaunchTemplateData)
+		}
+
+		tfMap := map[string]interface{}{
+			"changed_attributes":   changes,
+			"created_by":           aws.StringValue(v.CreatedBy),
+			"default_version":      aws.BoolValue(v.DefaultVersion),
+			"launch_template_data": string(data),
+			"version_description":  aws.StringValue(v.VersionDescription),
+			"version_number":       aws.Int64Value(v.VersionNumber),
+		}
+
+		if v.CreateTime != nil {
+			tfMap["create_time"] = aws.TimeValue(v.CreateTime).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(id)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("launch_template_id", lt.LaunchTemplateId)
+	d.Set("launch_template_name", lt.LaunchTemplateName)
+
+	if err := d.Set("versions", tfList); err != nil {
+		return fmt.Errorf("error setting versions: %w", err)
+	}
+
+	return nil
+}
+
+// launchTemplateDataAttributeNames maps launch template data fields to aws_launch_template arguments
+// where the name is not the field name in snake case.
+var launchTemplateDataAttributeNames = map[string]string{
+	"ElasticInferenceAccelerators": "elastic_inference_accelerator",
+	"LicenseSpecifications":        "license_specification",
+	"SecurityGroupIds":             "vpc_security_group_ids",
+	"SecurityGroups":               "security_group_names",
+}
+
+// launchTemplateDataChangedAttributes returns the names of the aws_launch_template arguments that differ between two versions' launch template data.
+func launchTemplateDataChangedAttributes(old, new *ec2.ResponseLaunchTemplateData) []string {
+	if old == nil {
+		old = &ec2.ResponseLaunchTemplateData{}
+	}
+
+	if new == nil {
+		new = &ec2.ResponseLaunchTemplateData{}
+	}
+
+	var changes []string
+
+	o, n := reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem()
+
+	for i := 0; i < o.NumField(); i++ {
+		field := o.Type().Field(i)
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		if reflect.DeepEqual(o.Field(i).Interface(), n.Field(i).Interface()) {
+			continue
+		}
+
+		name, ok := launchTemplateDataAttributeNames[field.Name]
+
+		if !ok {
+			name = launchTemplateDataAttributeName(field.Name)
+		}
+
+		changes = append(changes, name)
+	}
+
+	sort.Strings(changes)
+
+	return changes
+}
+
+func launchTemplateDataAttributeName(fieldName string) string {
+	var sb strings.Builder
+
+	for i, r := range fieldName {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				sb.WriteRune('_')
+			}
+
+			r = unicode.ToLower(r)
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
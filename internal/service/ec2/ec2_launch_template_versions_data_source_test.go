@@ -0,0 +1,102 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2LaunchTemplateVersionsDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_versions.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionsDataSourceConfig(rName, "t3.micro"),
+			},
+			{
+				Config: testAccLaunchTemplateVersionsDataSourceConfig(rName, "t3.small"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "launch_template_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "name", dataSourceName, "launch_template_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.changed_attributes.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.default_version", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.version_number", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.create_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.0.created_by"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.changed_attributes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.changed_attributes.0", "instance_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.default_version", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.version_number", "2"),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.1.launch_template_data", regexp.MustCompile(`"InstanceType":"t3.small"`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersionsDataSource_name(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_launch_template_versions.test"
+	resourceName := "aws_launch_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionsDataSourceNameConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "launch_template_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_version", dataSourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(resourceName, "latest_version", dataSourceName, "latest_version"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchTemplateVersionsDataSourceConfig(rName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name            = %[1]q
+  instance_type   = %[2]q
+  default_version = 1
+}
+
+data "aws_launch_template_versions" "test" {
+  launch_template_id = aws_launch_template.test.id
+
+  depends_on = [aws_launch_template.test]
+}
+`, rName, instanceType)
+}
+
+func testAccLaunchTemplateVersionsDataSourceNameConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+}
+
+data "aws_launch_template_versions" "test" {
+  launch_template_name = aws_launch_template.test.name
+}
+`, rName)
+}
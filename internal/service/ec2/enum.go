@@ -13,6 +13,20 @@ func CPUCredits_Values() []string {
 	}
 }
 
+const (
+	// The default version is only changed by setting default_version.
+	LaunchTemplateSetDefaultVersionExplicit = "explicit"
+	// Each new version becomes the default version.
+	LaunchTemplateSetDefaultVersionLatest = "latest"
+)
+
+func LaunchTemplateSetDefaultVersion_Values() []string {
+	return []string{
+		LaunchTemplateSetDefaultVersionExplicit,
+		LaunchTemplateSetDefaultVersionLatest,
+	}
+}
+
 const (
 	// https://docs.aws.amazon.com/vpc/latest/privatelink/vpce-interface.html#vpce-interface-lifecycle
 	VpcEndpointStateAvailable         = "available"
@@ -131,3 +131,23 @@ func UnsuccessfulItemsError(apiObjects []*ec2.UnsuccessfulItem) error {
 
 	return errors.ErrorOrNil()
 }
+
+func DeleteLaunchTemplateVersionsError(apiObject *ec2.DeleteLaunchTemplateVersionsResponseErrorItem) error {
+	if apiObject == nil || apiObject.ResponseError == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.ResponseError.Code), aws.StringValue(apiObject.ResponseError.Message), nil)
+}
+
+func DeleteLaunchTemplateVersionsErrors(apiObjects []*ec2.DeleteLaunchTemplateVersionsResponseErrorItem) error {
+	var errors *multierror.Error
+
+	for _, apiObject := range apiObjects {
+		if err := DeleteLaunchTemplateVersionsError(apiObject); err != nil {
+			errors = multierror.Append(errors, fmt.Errorf("version %d: %w", aws.Int64Value(apiObject.VersionNumber), err))
+		}
+	}
+
+	return errors.ErrorOrNil()
+}
@@ -2,6 +2,7 @@ package ecr
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -38,3 +39,179 @@ func FindPullThroughCacheRuleByRepositoryPrefix(ctx context.Context, conn *ecr.E
 
 	return output.PullThroughCacheRules[0], nil
 }
+
+func FindImageByRepositoryNameAndDigest(ctx context.Context, conn *ecr.ECR, registryID, repositoryName, imageDigest string) (*ecr.ImageDetail, error) {
+	input := &ecr.DescribeImagesInput{
+		ImageIds: []*ecr.ImageIdentifier{{
+			ImageDigest: aws.String(imageDigest),
+		}},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+
+	output, err := conn.DescribeImagesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageNotFoundException, ecr.ErrCodeRepositoryNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ImageDetails) == 0 || output.ImageDetails[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.ImageDetails); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.ImageDetails[0], nil
+}
+
+// findImageManifestByRepositoryNameAndDigest returns the image with the specified digest, including its manifest.
+// The manifest is returned in its stored format.
+func findImageManifestByRepositoryNameAndDigest(ctx context.Context, conn *ecr.ECR, registryID, repositoryName, imageDigest string) (*ecr.Image, error) {
+	input := &ecr.BatchGetImageInput{
+		AcceptedMediaTypes: aws.StringSlice(imageManifestMediaTypes()),
+		ImageIds: []*ecr.ImageIdentifier{{
+			ImageDigest: aws.String(imageDigest),
+		}},
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+
+	output, err := conn.BatchGetImageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Failures {
+		if aws.StringValue(v.FailureCode) == ecr.ImageFailureCodeImageNotFound {
+			return nil, &resource.NotFoundError{
+				Message:     aws.StringValue(v.FailureReason),
+				LastRequest: input,
+			}
+		}
+
+		return nil, fmt.Errorf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason))
+	}
+
+	// An image is returned for each of its tags.
+	if len(output.Images) == 0 || output.Images[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Images[0], nil
+}
+
+// findImageManifestsByRepositoryNameAndTags returns the images that the specified tags refer to, including their manifests, keyed by tag.
+// Tags that do not exist are omitted.
+func findImageManifestsByRepositoryNameAndTags(ctx context.Context, conn *ecr.ECR, registryID, repositoryName string, tags []string) (map[string]*ecr.Image, error) {
+	input := &ecr.BatchGetImageInput{
+		AcceptedMediaTypes: aws.StringSlice(imageManifestMediaTypes()),
+		RepositoryName:     aws.String(repositoryName),
+	}
+
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+
+	for _, tag := range tags {
+		input.ImageIds = append(input.ImageIds, &ecr.ImageIdentifier{
+			ImageTag: aws.String(tag),
+		})
+	}
+
+	output, err := conn.BatchGetImageWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range output.Failures {
+		if aws.StringValue(v.FailureCode) == ecr.ImageFailureCodeImageNotFound {
+			continue
+		}
+
+		return nil, fmt.Errorf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason))
+	}
+
+	images := make(map[string]*ecr.Image, len(output.Images))
+
+	for _, v := range output.Images {
+		if v == nil || v.ImageId == nil {
+			continue
+		}
+
+		images[aws.StringValue(v.ImageId.ImageTag)] = v
+	}
+
+	return images, nil
+}
+
+func findRepositoryByName(ctx context.Context, conn *ecr.ECR, registryID, repositoryName string) (*ecr.Repository, error) {
+	input := &ecr.DescribeRepositoriesInput{
+		RepositoryNames: aws.StringSlice([]string{repositoryName}),
+	}
+
+	if registryID != "" {
+		input.RegistryId = aws.String(registryID)
+	}
+
+	output, err := conn.DescribeRepositoriesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Repositories) == 0 || output.Repositories[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Repositories); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output.Repositories[0], nil
+}
@@ -0,0 +1,25 @@
+package ecr
+
+import (
+	"fmt"
+	"strings"
+)
+
+const imageCopyResourceIDSeparator = ","
+
+func ImageCopyCreateResourceID(registryID, repositoryName, imageDigest string) string {
+	parts := []string{registryID, repositoryName, imageDigest}
+	id := strings.Join(parts, imageCopyResourceIDSeparator)
+
+	return id
+}
+
+func ImageCopyParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, imageCopyResourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected REGISTRYID%[2]sREPOSITORYNAME%[2]sIMAGEDIGEST", id, imageCopyResourceIDSeparator)
+}
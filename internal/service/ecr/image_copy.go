@@ -0,0 +1,791 @@
+package ecr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// Part size used if Amazon ECR does not return one when a layer upload is initiated.
+	defaultLayerPartSize = 10 * 1024 * 1024
+
+	// Maximum number of layer digests in a single BatchCheckLayerAvailability call.
+	layerAvailabilityBatchSize = 100
+)
+
+func ResourceImageCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImageCopyCreate,
+		ReadContext:   resourceImageCopyRead,
+		UpdateContext: resourceImageCopyUpdate,
+		DeleteContext: resourceImageCopyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"copied_manifest_digests": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"image_copied": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"image_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_manifest_media_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 300),
+				},
+			},
+			"registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_image_digest": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-z0-9]+(?:[+._-][a-z0-9]+)*:[a-zA-Z0-9=_-]+$`),
+					"must be an image digest, e.g. sha256:..."),
+			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_registry_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"source_repository_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceImageCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	sourceConn := conn
+	if v, ok := d.GetOk("source_region"); ok && v.(string) != meta.(*conns.AWSClient).Region {
+		sess, err := conns.NewSessionForRegion(&conn.Config, v.(string), meta.(*conns.AWSClient).TerraformVersion)
+
+		if err != nil {
+			return diag.Errorf("error creating AWS session for region (%s): %s", v.(string), err)
+		}
+
+		sourceConn = ecr.New(sess)
+	}
+
+	c := &imageCopier{
+		conn:                 conn,
+		registryID:           d.Get("registry_id").(string),
+		repositoryName:       d.Get("repository_name").(string),
+		sourceConn:           sourceConn,
+		sourceRegistryID:     d.Get("source_registry_id").(string),
+		sourceRepositoryName: d.Get("source_repository_name").(string),
+	}
+	digest := d.Get("source_image_digest").(string)
+
+	image, err := c.copyImage(ctx, digest)
+
+	if err != nil {
+		return diag.Errorf("error copying ECR Image (%s) from repository (%s) to repository (%s): %s", digest, c.sourceRepositoryName, c.repositoryName, err)
+	}
+
+	if v, ok := d.GetOk("image_tags"); ok && v.(*schema.Set).Len() > 0 {
+		if err := putImageTags(ctx, conn, image, aws.StringValueSlice(flex.ExpandStringSet(v.(*schema.Set)))); err != nil {
+			// Don't leave behind copied images that are not tracked in state.
+			if c.imageCopied {
+				if err := deleteCopiedImages(ctx, conn, aws.StringValue(image.RegistryId), c.repositoryName, digest, c.copiedManifestDigests); err != nil {
+					log.Printf("[WARN] Error deleting ECR Image (%s) from repository (%s): %s", digest, c.repositoryName, err)
+				}
+			}
+
+			return diag.Errorf("error tagging ECR Image (%s) in repository (%s): %s", digest, c.repositoryName, err)
+		}
+	}
+
+	d.SetId(ImageCopyCreateResourceID(aws.StringValue(image.RegistryId), c.repositoryName, digest))
+	d.Set("copied_manifest_digests", c.copiedManifestDigests)
+	d.Set("image_copied", c.imageCopied)
+
+	return resourceImageCopyRead(ctx, d, meta)
+}
+
+func resourceImageCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	registryID, repositoryName, digest, err := ImageCopyParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := FindImageByRepositoryNameAndDigest(ctx, conn, registryID, repositoryName, digest)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECR Image (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading ECR Image (%s): %s", d.Id(), err)
+	}
+
+	d.Set("image_digest", image.ImageDigest)
+	d.Set("image_manifest_media_type", image.ImageManifestMediaType)
+	d.Set("registry_id", image.RegistryId)
+	d.Set("repository_name", image.RepositoryName)
+
+	// Only report the tags managed by this resource, so that tags added by other means do not cause a difference.
+	var imageTags []string
+	managed := d.Get("image_tags").(*schema.Set)
+	for _, v := range aws.StringValueSlice(image.ImageTags) {
+		if managed.Contains(v) {
+			imageTags = append(imageTags, v)
+		}
+	}
+
+	d.Set("image_tags", imageTags)
+
+	return nil
+}
+
+func resourceImageCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	if d.HasChange("image_tags") {
+		registryID, repositoryName, digest, err := ImageCopyParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		o, n := d.GetChange("image_tags")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Add tags before removing any, so that the image is only deleted if all of its tags are removed.
+		if add := ns.Difference(os); add.Len() > 0 {
+			image, err := findImageManifestByRepositoryNameAndDigest(ctx, conn, registryID, repositoryName, digest)
+
+			if err != nil {
+				return diag.Errorf("error reading ECR Image (%s): %s", d.Id(), err)
+			}
+
+			if err := putImageTags(ctx, conn, image, aws.StringValueSlice(flex.ExpandStringSet(add))); err != nil {
+				return diag.Errorf("error tagging ECR Image (%s): %s", d.Id(), err)
+			}
+		}
+
+		if del := os.Difference(ns); del.Len() > 0 {
+			if err := deleteImageTags(ctx, conn, registryID, repositoryName, digest, aws.StringValueSlice(flex.ExpandStringSet(del))); err != nil {
+				return diag.Errorf("error untagging ECR Image (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceImageCopyRead(ctx, d, meta)
+}
+
+func resourceImageCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ECRConn
+
+	registryID, repositoryName, digest, err := ImageCopyParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// An image that already existed in the destination repository is kept, only the tags managed by this resource are removed.
+	if !d.Get("image_copied").(bool) {
+		image, err := FindImageByRepositoryNameAndDigest(ctx, conn, registryID, repositoryName, digest)
+
+		if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("error reading ECR Image (%s): %s", d.Id(), err)
+		}
+
+		managed := d.Get("image_tags").(*schema.Set)
+		var tags, otherTags []string
+
+		for _, v := range aws.StringValueSlice(image.ImageTags) {
+			if managed.Contains(v) {
+				tags = append(tags, v)
+			} else {
+				otherTags = append(otherTags, v)
+			}
+		}
+
+		// Removing the last tag from an image deletes the image, so the last managed tag is left in place.
+		if len(tags) > 0 && len(otherTags) == 0 {
+			sort.Strings(tags)
+			log.Printf("[WARN] ECR Image (%s) has no other tags, leaving tag (%s) in place so that the image is not deleted", d.Id(), tags[0])
+			tags = tags[1:]
+		}
+
+		if len(tags) == 0 {
+			return nil
+		}
+
+		log.Printf("[DEBUG] Deleting ECR Image tags: %s", d.Id())
+		err = deleteImageTags(ctx, conn, registryID, repositoryName, digest, tags)
+
+		if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("error untagging ECR Image (%s): %s", d.Id(), err)
+		}
+
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting ECR Image: %s", d.Id())
+	err = deleteCopiedImages(ctx, conn, registryID, repositoryName, digest, aws.StringValueSlice(flex.ExpandStringList(d.Get("copied_manifest_digests").([]interface{}))))
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeRepositoryNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting ECR Image (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// imageCopier copies images between Amazon ECR repositories, possibly in different registries or regions.
+type imageCopier struct {
+	conn                 *ecr.ECR
+	registryID           string
+	repositoryName       string
+	sourceConn           *ecr.ECR
+	sourceRegistryID     string
+	sourceRepositoryName string
+
+	// The digests of the images referenced by a manifest list or image index that were copied, in the order they were copied.
+	copiedManifestDigests []string
+	// Whether the image itself was copied, i.e. it did not already exist in the destination repository.
+	imageCopied bool
+}
+
+// copyImage copies the image with the specified digest, including any images referenced by a manifest list
+// or image index and the layers they reference, and returns the copied image.
+// An image that already exists in the destination repository is returned as is, so that copying is idempotent on digest.
+// Referenced images and layers that already exist in the destination repository are not copied again.
+// The copied images are untagged. If the copy fails, the referenced images copied so far are deleted.
+func (c *imageCopier) copyImage(ctx context.Context, digest string) (*ecr.Image, error) {
+	image, err := findImageManifestByRepositoryNameAndDigest(ctx, c.conn, c.registryID, c.repositoryName, digest)
+
+	if err == nil {
+		log.Printf("[DEBUG] ECR Image (%s) already exists in repository (%s)", digest, c.repositoryName)
+		return image, nil
+	}
+
+	if !tfresource.NotFound(err) {
+		return nil, fmt.Errorf("error reading image (%s): %w", digest, err)
+	}
+
+	image, err = c.putImage(ctx, digest)
+
+	if err != nil {
+		if err := deleteCopiedImages(ctx, c.conn, c.registryID, c.repositoryName, "", c.copiedManifestDigests); err != nil {
+			log.Printf("[WARN] Error deleting ECR Images copied to repository (%s): %s", c.repositoryName, err)
+		}
+
+		c.copiedManifestDigests = nil
+
+		return nil, err
+	}
+
+	c.imageCopied = true
+
+	return image, nil
+}
+
+// copyManifest copies an image referenced by a manifest list or image index, unless it already exists in the destination repository.
+func (c *imageCopier) copyManifest(ctx context.Context, digest string) error {
+	_, err := findImageManifestByRepositoryNameAndDigest(ctx, c.conn, c.registryID, c.repositoryName, digest)
+
+	if err == nil {
+		log.Printf("[DEBUG] ECR Image (%s) already exists in repository (%s)", digest, c.repositoryName)
+		return nil
+	}
+
+	if !tfresource.NotFound(err) {
+		return fmt.Errorf("error reading image (%s): %w", digest, err)
+	}
+
+	_, err = c.putImage(ctx, digest)
+
+	// The image was put by other means since it was read.
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageAlreadyExistsException) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	c.copiedManifestDigests = append(c.copiedManifestDigests, digest)
+
+	return nil
+}
+
+// putImage copies the image with the specified digest, the images it references and their layers to the destination repository.
+func (c *imageCopier) putImage(ctx context.Context, digest string) (*ecr.Image, error) {
+	// A missing destination repository is reported as an error by PutImage.
+	image, err := findImageManifestByRepositoryNameAndDigest(ctx, c.sourceConn, c.sourceRegistryID, c.sourceRepositoryName, digest)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading source image (%s): %w", digest, err)
+	}
+
+	manifests, blobs, err := imageManifestReferences(aws.StringValue(image.ImageManifestMediaType), aws.StringValue(image.ImageManifest))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range manifests {
+		if err := c.copyManifest(ctx, v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.copyLayers(ctx, blobs); err != nil {
+		return nil, err
+	}
+
+	input := &ecr.PutImageInput{
+		ImageDigest:            aws.String(digest),
+		ImageManifest:          image.ImageManifest,
+		ImageManifestMediaType: image.ImageManifestMediaType,
+		RepositoryName:         aws.String(c.repositoryName),
+	}
+
+	if c.registryID != "" {
+		input.RegistryId = aws.String(c.registryID)
+	}
+
+	log.Printf("[DEBUG] Putting ECR Image: %s", digest)
+	output, err := c.conn.PutImageWithContext(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error putting image (%s): %w", digest, err)
+	}
+
+	return output.Image, nil
+}
+
+// copyLayers copies the layers with the specified digests that are not available in the destination repository.
+func (c *imageCopier) copyLayers(ctx context.Context, digests []string) error {
+	var missing []string
+
+	for i := 0; i < len(digests); i += layerAvailabilityBatchSize {
+		j := i + layerAvailabilityBatchSize
+		if j > len(digests) {
+			j = len(digests)
+		}
+
+		input := &ecr.BatchCheckLayerAvailabilityInput{
+			LayerDigests:   aws.StringSlice(digests[i:j]),
+			RepositoryName: aws.String(c.repositoryName),
+		}
+
+		if c.registryID != "" {
+			input.RegistryId = aws.String(c.registryID)
+		}
+
+		output, err := c.conn.BatchCheckLayerAvailabilityWithContext(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("error checking layer availability: %w", err)
+		}
+
+		for _, v := range output.Layers {
+			if aws.StringValue(v.LayerAvailability) != ecr.LayerAvailabilityAvailable {
+				missing = append(missing, aws.StringValue(v.LayerDigest))
+			}
+		}
+
+		for _, v := range output.Failures {
+			if aws.StringValue(v.FailureCode) != ecr.LayerFailureCodeMissingLayerDigest {
+				return fmt.Errorf("error checking layer (%s) availability: %s: %s", aws.StringValue(v.LayerDigest), aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason))
+			}
+
+			missing = append(missing, aws.StringValue(v.LayerDigest))
+		}
+	}
+
+	for _, v := range missing {
+		if err := c.copyLayer(ctx, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyLayer downloads a layer from the source repository and uploads it in parts to the destination repository.
+func (c *imageCopier) copyLayer(ctx context.Context, digest string) error {
+	downloadInput := &ecr.GetDownloadUrlForLayerInput{
+		LayerDigest:    aws.String(digest),
+		RepositoryName: aws.String(c.sourceRepositoryName),
+	}
+
+	if c.sourceRegistryID != "" {
+		downloadInput.RegistryId = aws.String(c.sourceRegistryID)
+	}
+
+	download, err := c.sourceConn.GetDownloadUrlForLayerWithContext(ctx, downloadInput)
+
+	if err != nil {
+		return fmt.Errorf("error getting download URL for layer (%s): %w", digest, err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, aws.StringValue(download.DownloadUrl), nil)
+
+	if err != nil {
+		return fmt.Errorf("error downloading layer (%s): %w", digest, err)
+	}
+
+	client := c.sourceConn.Config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	response, err := client.Do(request)
+
+	if err != nil {
+		return fmt.Errorf("error downloading layer (%s): %w", digest, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("error downloading layer (%s): unexpected HTTP status: %s", digest, response.Status)
+	}
+
+	initiateInput := &ecr.InitiateLayerUploadInput{
+		RepositoryName: aws.String(c.repositoryName),
+	}
+
+	if c.registryID != "" {
+		initiateInput.RegistryId = aws.String(c.registryID)
+	}
+
+	upload, err := c.conn.InitiateLayerUploadWithContext(ctx, initiateInput)
+
+	if err != nil {
+		return fmt.Errorf("error initiating upload of layer (%s): %w", digest, err)
+	}
+
+	partSize := aws.Int64Value(upload.PartSize)
+	if partSize <= 0 {
+		partSize = defaultLayerPartSize
+	}
+
+	log.Printf("[DEBUG] Uploading ECR layer (%s) in parts of %d bytes", digest, partSize)
+	buf := make([]byte, partSize)
+	var offset int64
+
+	for {
+		n, err := io.ReadFull(response.Body, buf)
+
+		if n > 0 {
+			input := &ecr.UploadLayerPartInput{
+				LayerPartBlob:  buf[:n],
+				PartFirstByte:  aws.Int64(offset),
+				PartLastByte:   aws.Int64(offset + int64(n) - 1),
+				RegistryId:     initiateInput.RegistryId,
+				RepositoryName: aws.String(c.repositoryName),
+				UploadId:       upload.UploadId,
+			}
+
+			if _, err := c.conn.UploadLayerPartWithContext(ctx, input); err != nil {
+				return fmt.Errorf("error uploading layer (%s) part: %w", digest, err)
+			}
+
+			offset += int64(n)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("error downloading layer (%s): %w", digest, err)
+		}
+	}
+
+	_, err = c.conn.CompleteLayerUploadWithContext(ctx, &ecr.CompleteLayerUploadInput{
+		LayerDigests:   aws.StringSlice([]string{digest}),
+		RegistryId:     initiateInput.RegistryId,
+		RepositoryName: aws.String(c.repositoryName),
+		UploadId:       upload.UploadId,
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecr.ErrCodeLayerAlreadyExistsException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error completing upload of layer (%s): %w", digest, err)
+	}
+
+	return nil
+}
+
+// putImageTags adds tags to an image. Amazon ECR adds a single tag per PutImage call, so before any tag is added
+// the tags are checked against the repository's tag mutability, and if adding a tag fails, the tags added so far are reverted.
+// Each PutImage call references the image digest, so a tag either refers to the complete image or is not changed.
+func putImageTags(ctx context.Context, conn *ecr.ECR, image *ecr.Image, tags []string) error {
+	registryID, repositoryName, digest := aws.StringValue(image.RegistryId), aws.StringValue(image.RepositoryName), aws.StringValue(image.ImageId.ImageDigest)
+
+	repository, err := findRepositoryByName(ctx, conn, registryID, repositoryName)
+
+	if err != nil {
+		return fmt.Errorf("error reading repository (%s): %w", repositoryName, err)
+	}
+
+	previous, err := findImageManifestsByRepositoryNameAndTags(ctx, conn, registryID, repositoryName, tags)
+
+	if err != nil {
+		return fmt.Errorf("error reading images by tag: %w", err)
+	}
+
+	if aws.StringValue(repository.ImageTagMutability) == ecr.ImageTagMutabilityImmutable {
+		var conflicts []string
+
+		for _, tag := range tags {
+			if v, ok := previous[tag]; ok && aws.StringValue(v.ImageId.ImageDigest) != digest {
+				conflicts = append(conflicts, tag)
+			}
+		}
+
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+
+			return fmt.Errorf("tags (%s) refer to other images and the repository's image tags are immutable", strings.Join(conflicts, ", "))
+		}
+	}
+
+	var added []string
+
+	for _, tag := range tags {
+		if v, ok := previous[tag]; ok && aws.StringValue(v.ImageId.ImageDigest) == digest {
+			continue
+		}
+
+		input := &ecr.PutImageInput{
+			ImageDigest:            image.ImageId.ImageDigest,
+			ImageManifest:          image.ImageManifest,
+			ImageManifestMediaType: image.ImageManifestMediaType,
+			ImageTag:               aws.String(tag),
+			RegistryId:             image.RegistryId,
+			RepositoryName:         image.RepositoryName,
+		}
+
+		log.Printf("[DEBUG] Putting ECR Image tag: %s", input)
+		_, err := conn.PutImageWithContext(ctx, input)
+
+		// The tag already refers to the image.
+		if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageAlreadyExistsException) {
+			continue
+		}
+
+		if err != nil {
+			var errs *multierror.Error
+
+			errs = multierror.Append(errs, fmt.Errorf("error putting tag (%s): %w", tag, err))
+
+			if err := revertImageTags(ctx, conn, image, added, previous); err != nil {
+				errs = multierror.Append(errs, err)
+			}
+
+			return errs.ErrorOrNil()
+		}
+
+		added = append(added, tag)
+	}
+
+	return nil
+}
+
+// revertImageTags reverts tags added to an image: tags that referred to another image are moved back to it, the others are removed.
+// Removing the last tag from an image deletes the image.
+func revertImageTags(ctx context.Context, conn *ecr.ECR, image *ecr.Image, tags []string, previous map[string]*ecr.Image) error {
+	var errs *multierror.Error
+	var remove []string
+
+	for _, tag := range tags {
+		v, ok := previous[tag]
+
+		if !ok {
+			remove = append(remove, tag)
+			continue
+		}
+
+		input := &ecr.PutImageInput{
+			ImageDigest:            v.ImageId.ImageDigest,
+			ImageManifest:          v.ImageManifest,
+			ImageManifestMediaType: v.ImageManifestMediaType,
+			ImageTag:               aws.String(tag),
+			RegistryId:             image.RegistryId,
+			RepositoryName:         image.RepositoryName,
+		}
+
+		log.Printf("[DEBUG] Reverting ECR Image tag: %s", input)
+		_, err := conn.PutImageWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, ecr.ErrCodeImageAlreadyExistsException) {
+			continue
+		}
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error reverting tag (%s): %w", tag, err))
+		}
+	}
+
+	if len(remove) > 0 {
+		if err := deleteImageTags(ctx, conn, aws.StringValue(image.RegistryId), aws.StringValue(image.RepositoryName), aws.StringValue(image.ImageId.ImageDigest), remove); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error reverting tags (%s): %w", strings.Join(remove, ", "), err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// deleteImageTags removes tags from an image. Tags that no longer refer to the image are not removed.
+// Removing the last tag from an image deletes the image.
+func deleteImageTags(ctx context.Context, conn *ecr.ECR, registryID, repositoryName, digest string, tags []string) error {
+	input := &ecr.BatchDeleteImageInput{
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+	}
+
+	for _, tag := range tags {
+		input.ImageIds = append(input.ImageIds, &ecr.ImageIdentifier{
+			ImageDigest: aws.String(digest),
+			ImageTag:    aws.String(tag),
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting ECR Image tags: %s", input)
+	output, err := conn.BatchDeleteImageWithContext(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range output.Failures {
+		if aws.StringValue(v.FailureCode) == ecr.ImageFailureCodeImageTagDoesNotMatchDigest || aws.StringValue(v.FailureCode) == ecr.ImageFailureCodeImageNotFound {
+			continue
+		}
+
+		return fmt.Errorf("error deleting tag (%s): %s: %s", aws.StringValue(v.ImageId.ImageTag), aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason))
+	}
+
+	return nil
+}
+
+// deleteCopiedImages deletes the image with the specified digest, if any, and then the referenced images that were copied with it
+// in the reverse order they were copied, as an image cannot be deleted while a manifest list or image index references it.
+// Images that are referenced by another manifest list or image index are not deleted.
+func deleteCopiedImages(ctx context.Context, conn *ecr.ECR, registryID, repositoryName, digest string, manifestDigests []string) error {
+	var digests []string
+
+	if digest != "" {
+		digests = append(digests, digest)
+	}
+
+	for i := len(manifestDigests) - 1; i >= 0; i-- {
+		digests = append(digests, manifestDigests[i])
+	}
+
+	for _, digest := range digests {
+		input := &ecr.BatchDeleteImageInput{
+			ImageIds: []*ecr.ImageIdentifier{{
+				ImageDigest: aws.String(digest),
+			}},
+			RepositoryName: aws.String(repositoryName),
+		}
+
+		if registryID != "" {
+			input.RegistryId = aws.String(registryID)
+		}
+
+		log.Printf("[DEBUG] Deleting ECR Image: %s", input)
+		output, err := conn.BatchDeleteImageWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Failures {
+			switch aws.StringValue(v.FailureCode) {
+			case ecr.ImageFailureCodeImageNotFound:
+			case ecr.ImageFailureCodeImageReferencedByManifestList:
+				log.Printf("[WARN] ECR Image (%s) in repository (%s) is referenced by another manifest list or image index, not deleting", digest, repositoryName)
+			default:
+				return fmt.Errorf("error deleting image (%s): %s: %s", digest, aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,550 @@
+package ecr_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfecr "github.com/hashicorp/terraform-provider-aws/internal/service/ecr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccECRImageCopy_basic(t *testing.T) {
+	var image ecr.ImageDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+	dataSourceName := "data.aws_ecr_image.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckImageCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyBaseConfig(rName),
+			},
+			{
+				PreConfig: func() { testAccImageCopyPushImage(t, rName+"-source", "build", false) },
+				Config:    testAccImageCopyConfig(rName, "release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "image_copied", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "image_digest", dataSourceName, "image_digest"),
+					resource.TestCheckResourceAttr(resourceName, "image_manifest_media_type", "application/vnd.oci.image.manifest.v1+json"),
+					resource.TestCheckResourceAttr(resourceName, "image_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "release"),
+					acctest.CheckResourceAttrAccountID(resourceName, "registry_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "repository_name", "aws_ecr_repository.destination", "name"),
+				),
+			},
+			{
+				Config: testAccImageCopyConfig(rName, "release", "stable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "image_tags.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "release"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "stable"),
+				),
+			},
+			{
+				Config: testAccImageCopyConfig(rName, "stable"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "image_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "stable"),
+					testAccCheckImageCopyTag(&image, "stable"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImageCopy_disappears(t *testing.T) {
+	var image ecr.ImageDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckImageCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyBaseConfig(rName),
+			},
+			{
+				PreConfig: func() { testAccImageCopyPushImage(t, rName+"-source", "build", false) },
+				Config:    testAccImageCopyConfig(rName, "release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists(resourceName, &image),
+					acctest.CheckResourceDisappears(acctest.Provider, tfecr.ResourceImageCopy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccECRImageCopy_imageIndex(t *testing.T) {
+	var image ecr.ImageDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+	dataSourceName := "data.aws_ecr_image.source"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckImageCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyBaseConfig(rName),
+			},
+			{
+				PreConfig: func() { testAccImageCopyPushImage(t, rName+"-source", "build", true) },
+				Config:    testAccImageCopyConfig(rName, "release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists(resourceName, &image),
+					resource.TestCheckResourceAttrPair(resourceName, "image_digest", dataSourceName, "image_digest"),
+					resource.TestCheckResourceAttr(resourceName, "image_manifest_media_type", "application/vnd.oci.image.index.v1+json"),
+					resource.TestCheckResourceAttr(resourceName, "copied_manifest_digests.#", "2"),
+					testAccCheckImageCopyImageCount(resourceName, 3),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImageCopy_existingImage(t *testing.T) {
+	var image ecr.ImageDetail
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckImageCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyBaseConfig(rName),
+			},
+			{
+				PreConfig: func() { testAccImageCopyPushImage(t, rName+"-source", "build", false) },
+				Config:    testAccImageCopyConfig_existingImage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyExists(resourceName, &image),
+					resource.TestCheckResourceAttr(resourceName, "copied_manifest_digests.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "image_copied", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECRImageCopy_immutableTagConflict(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecr_image_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckImageCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageCopyConfig_immutableBase(rName),
+			},
+			{
+				PreConfig: func() {
+					testAccImageCopyPushImage(t, rName+"-source", "build", false)
+					testAccImageCopyPushImage(t, rName+"-destination", "release", true)
+				},
+				Config:      testAccImageCopyConfig_immutable(rName, "stable", "release"),
+				ExpectError: regexp.MustCompile(`tags \(release\) refer to other images`),
+			},
+			{
+				// Neither the copied image nor the "stable" tag is left behind.
+				Config: testAccImageCopyConfig_immutableBase(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageCopyRepositoryImageCount(rName+"-destination", 3),
+				),
+			},
+			{
+				Config: testAccImageCopyConfig_immutable(rName, "stable"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "image_tags.*", "stable"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImageCopyDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecr_image_copy" {
+			continue
+		}
+
+		registryID, repositoryName, digest, err := tfecr.ImageCopyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var digests []string
+
+		// An image that already existed in the destination repository is not deleted.
+		if rs.Primary.Attributes["image_copied"] == "true" {
+			digests = append(digests, digest)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "copied_manifest_digests.") && k != "copied_manifest_digests.#" {
+				digests = append(digests, v)
+			}
+		}
+
+		for _, digest := range digests {
+			_, err = tfecr.FindImageByRepositoryNameAndDigest(context.Background(), conn, registryID, repositoryName, digest)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ECR Image %s (%s) still exists", rs.Primary.ID, digest)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImageCopyExists(n string, v *ecr.ImageDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECR Image ID is set")
+		}
+
+		registryID, repositoryName, digest, err := tfecr.ImageCopyParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+		output, err := tfecr.FindImageByRepositoryNameAndDigest(context.Background(), conn, registryID, repositoryName, digest)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccCheckImageCopyTag checks that the image has only the specified tag.
+func testAccCheckImageCopyTag(image *ecr.ImageDetail, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValueSlice(image.ImageTags); len(got) != 1 || got[0] != expected {
+			return fmt.Errorf("expected ECR Image tags [%s], got %v", expected, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckImageCopyImageCount checks the number of images, tagged or untagged, in the destination repository.
+func testAccCheckImageCopyImageCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+		output, err := conn.ListImages(&ecr.ListImagesInput{
+			RegistryId:     aws.String(rs.Primary.Attributes["registry_id"]),
+			RepositoryName: aws.String(rs.Primary.Attributes["repository_name"]),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.ImageIds); got != expected {
+			return fmt.Errorf("expected %d ECR Images, got %d", expected, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckImageCopyRepositoryImageCount checks the number of image IDs, i.e. digest and tag pairs, in a repository.
+func testAccCheckImageCopyRepositoryImageCount(repositoryName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+		output, err := conn.ListImages(&ecr.ListImagesInput{
+			RepositoryName: aws.String(repositoryName),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output.ImageIds); got != expected {
+			return fmt.Errorf("expected %d ECR Image IDs in repository (%s), got %d", expected, repositoryName, got)
+		}
+
+		return nil
+	}
+}
+
+// testAccImageCopyPushImage pushes a minimal single-layer OCI image, or an image index of two
+// such images, to a repository using the Amazon ECR API.
+func testAccImageCopyPushImage(t *testing.T, repositoryName, tag string, index bool) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECRConn
+
+	if !index {
+		testAccImageCopyPutImage(t, conn, repositoryName, tag, testAccImageCopyPushManifest(t, conn, repositoryName, "amd64"), "application/vnd.oci.image.manifest.v1+json")
+
+		return
+	}
+
+	var manifests []map[string]interface{}
+	for _, arch := range []string{"amd64", "arm64"} {
+		manifest := testAccImageCopyPushManifest(t, conn, repositoryName, arch)
+		testAccImageCopyPutImage(t, conn, repositoryName, "", manifest, "application/vnd.oci.image.manifest.v1+json")
+
+		manifests = append(manifests, map[string]interface{}{
+			"mediaType": "application/vnd.oci.image.manifest.v1+json",
+			"digest":    testAccImageCopyDigest(manifest),
+			"size":      len(manifest),
+			"platform":  map[string]string{"architecture": arch, "os": "linux"},
+		})
+	}
+
+	testAccImageCopyPutImage(t, conn, repositoryName, tag, testAccImageCopyJSON(t, map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.index.v1+json",
+		"manifests":     manifests,
+	}), "application/vnd.oci.image.index.v1+json")
+}
+
+// testAccImageCopyPushManifest uploads a layer and image config and returns the image manifest referencing them.
+func testAccImageCopyPushManifest(t *testing.T, conn *ecr.ECR, repositoryName, arch string) []byte {
+	var layerTar bytes.Buffer
+	tw := tar.NewWriter(&layerTar)
+	content := []byte(sdkacctest.RandString(32))
+	if err := tw.WriteHeader(&tar.Header{Name: "hello.txt", Mode: 0644, Size: int64(len(content))}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var layer bytes.Buffer
+	gw := gzip.NewWriter(&layer)
+	if _, err := gw.Write(layerTar.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	config := testAccImageCopyJSON(t, map[string]interface{}{
+		"architecture": arch,
+		"os":           "linux",
+		"config":       map[string]interface{}{},
+		"rootfs": map[string]interface{}{
+			"type":     "layers",
+			"diff_ids": []string{testAccImageCopyDigest(layerTar.Bytes())},
+		},
+	})
+
+	testAccImageCopyUploadBlob(t, conn, repositoryName, layer.Bytes())
+	testAccImageCopyUploadBlob(t, conn, repositoryName, config)
+
+	return testAccImageCopyJSON(t, map[string]interface{}{
+		"schemaVersion": 2,
+		"mediaType":     "application/vnd.oci.image.manifest.v1+json",
+		"config": map[string]interface{}{
+			"mediaType": "application/vnd.oci.image.config.v1+json",
+			"digest":    testAccImageCopyDigest(config),
+			"size":      len(config),
+		},
+		"layers": []map[string]interface{}{{
+			"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip",
+			"digest":    testAccImageCopyDigest(layer.Bytes()),
+			"size":      layer.Len(),
+		}},
+	})
+}
+
+func testAccImageCopyUploadBlob(t *testing.T, conn *ecr.ECR, repositoryName string, blob []byte) {
+	upload, err := conn.InitiateLayerUpload(&ecr.InitiateLayerUploadInput{
+		RepositoryName: aws.String(repositoryName),
+	})
+	if err != nil {
+		t.Fatalf("error initiating layer upload: %s", err)
+	}
+
+	_, err = conn.UploadLayerPart(&ecr.UploadLayerPartInput{
+		LayerPartBlob:  blob,
+		PartFirstByte:  aws.Int64(0),
+		PartLastByte:   aws.Int64(int64(len(blob) - 1)),
+		RepositoryName: aws.String(repositoryName),
+		UploadId:       upload.UploadId,
+	})
+	if err != nil {
+		t.Fatalf("error uploading layer part: %s", err)
+	}
+
+	_, err = conn.CompleteLayerUpload(&ecr.CompleteLayerUploadInput{
+		LayerDigests:   aws.StringSlice([]string{testAccImageCopyDigest(blob)}),
+		RepositoryName: aws.String(repositoryName),
+		UploadId:       upload.UploadId,
+	})
+	if err != nil {
+		t.Fatalf("error completing layer upload: %s", err)
+	}
+}
+
+func testAccImageCopyPutImage(t *testing.T, conn *ecr.ECR, repositoryName, tag string, manifest []byte, mediaType string) {
+	input := &ecr.PutImageInput{
+		ImageManifest:          aws.String(string(manifest)),
+		ImageManifestMediaType: aws.String(mediaType),
+		RepositoryName:         aws.String(repositoryName),
+	}
+
+	if tag != "" {
+		input.ImageTag = aws.String(tag)
+	}
+
+	if _, err := conn.PutImage(input); err != nil {
+		t.Fatalf("error putting image: %s", err)
+	}
+}
+
+func testAccImageCopyDigest(b []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(b))
+}
+
+func testAccImageCopyJSON(t *testing.T, v interface{}) []byte {
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return b
+}
+
+func testAccImageCopyBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "source" {
+  name = "%[1]s-source"
+}
+
+resource "aws_ecr_repository" "destination" {
+  name = "%[1]s-destination"
+}
+`, rName)
+}
+
+func testAccImageCopyConfig(rName string, tags ...string) string {
+	return acctest.ConfigCompose(testAccImageCopyBaseConfig(rName), fmt.Sprintf(`
+data "aws_ecr_image" "source" {
+  repository_name = aws_ecr_repository.source.name
+  image_tag       = "build"
+}
+
+resource "aws_ecr_image_copy" "test" {
+  source_repository_name = aws_ecr_repository.source.name
+  source_image_digest    = data.aws_ecr_image.source.image_digest
+
+  repository_name = aws_ecr_repository.destination.name
+  image_tags      = ["%[1]s"]
+}
+`, strings.Join(tags, `", "`)))
+}
+
+func testAccImageCopyConfig_existingImage(rName string) string {
+	return acctest.ConfigCompose(testAccImageCopyBaseConfig(rName), `
+data "aws_ecr_image" "source" {
+  repository_name = aws_ecr_repository.source.name
+  image_tag       = "build"
+}
+
+resource "aws_ecr_image_copy" "test" {
+  source_repository_name = aws_ecr_repository.source.name
+  source_image_digest    = data.aws_ecr_image.source.image_digest
+
+  repository_name = aws_ecr_repository.source.name
+}
+`)
+}
+
+func testAccImageCopyConfig_immutableBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecr_repository" "source" {
+  name = "%[1]s-source"
+}
+
+resource "aws_ecr_repository" "destination" {
+  name                 = "%[1]s-destination"
+  image_tag_mutability = "IMMUTABLE"
+}
+`, rName)
+}
+
+func testAccImageCopyConfig_immutable(rName string, tags ...string) string {
+	return acctest.ConfigCompose(testAccImageCopyConfig_immutableBase(rName), fmt.Sprintf(`
+data "aws_ecr_image" "source" {
+  repository_name = aws_ecr_repository.source.name
+  image_tag       = "build"
+}
+
+resource "aws_ecr_image_copy" "test" {
+  source_repository_name = aws_ecr_repository.source.name
+  source_image_digest    = data.aws_ecr_image.source.image_digest
+
+  repository_name = aws_ecr_repository.destination.name
+  image_tags      = ["%[1]s"]
+}
+`, strings.Join(tags, `", "`)))
+}
@@ -0,0 +1,106 @@
+package ecr
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Image manifest media types accepted by Amazon ECR.
+const (
+	mediaTypeDockerManifestList       = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeDockerManifestSchema1    = "application/vnd.docker.distribution.manifest.v1+json"
+	mediaTypeDockerManifestSchema1JWS = "application/vnd.docker.distribution.manifest.v1+prettyjws"
+	mediaTypeDockerManifestSchema2    = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeOCIImageIndex            = "application/vnd.oci.image.index.v1+json"
+	mediaTypeOCIImageManifest         = "application/vnd.oci.image.manifest.v1+json"
+)
+
+// Layer media types that are not stored in the registry.
+const (
+	mediaTypeDockerForeignLayer                = "application/vnd.docker.image.rootfs.foreign.diff.tar.gzip"
+	mediaTypeOCIImageLayerNonDistributable     = "application/vnd.oci.image.layer.nondistributable.v1.tar"
+	mediaTypeOCIImageLayerNonDistributableGzip = "application/vnd.oci.image.layer.nondistributable.v1.tar+gzip"
+	mediaTypeOCIImageLayerNonDistributableZstd = "application/vnd.oci.image.layer.nondistributable.v1.tar+zstd"
+)
+
+func imageManifestMediaTypes() []string {
+	return []string{
+		mediaTypeDockerManifestList,
+		mediaTypeDockerManifestSchema1,
+		mediaTypeDockerManifestSchema1JWS,
+		mediaTypeDockerManifestSchema2,
+		mediaTypeOCIImageIndex,
+		mediaTypeOCIImageManifest,
+	}
+}
+
+type imageManifestDescriptor struct {
+	Digest    string   `json:"digest"`
+	MediaType string   `json:"mediaType"`
+	URLs      []string `json:"urls,omitempty"`
+}
+
+type imageManifest struct {
+	MediaType string                    `json:"mediaType"`
+	Config    *imageManifestDescriptor  `json:"config"`
+	Layers    []imageManifestDescriptor `json:"layers"`
+	Manifests []imageManifestDescriptor `json:"manifests"`
+}
+
+// imageManifestReferences returns the digests of the manifests and blobs referenced by an image manifest.
+// Manifest lists and image indexes reference only manifests, image manifests reference only blobs.
+// Blobs that are not stored in the registry, such as Windows foreign layers, are not returned.
+func imageManifestReferences(mediaType, manifest string) ([]string, []string, error) {
+	var m imageManifest
+
+	if err := json.Unmarshal([]byte(manifest), &m); err != nil {
+		return nil, nil, fmt.Errorf("error parsing image manifest: %w", err)
+	}
+
+	// The media type is optional in the manifest but returned by Amazon ECR.
+	if mediaType == "" {
+		mediaType = m.MediaType
+	}
+
+	switch mediaType {
+	case mediaTypeDockerManifestList, mediaTypeOCIImageIndex:
+		var manifests []string
+
+		for _, v := range m.Manifests {
+			if v.Digest == "" {
+				return nil, nil, fmt.Errorf("image manifest (%s) references a manifest without a digest", mediaType)
+			}
+
+			manifests = append(manifests, v.Digest)
+		}
+
+		return manifests, nil, nil
+
+	case mediaTypeDockerManifestSchema2, mediaTypeOCIImageManifest:
+		var blobs []string
+
+		if m.Config == nil || m.Config.Digest == "" {
+			return nil, nil, fmt.Errorf("image manifest (%s) has no config digest", mediaType)
+		}
+
+		blobs = append(blobs, m.Config.Digest)
+
+		for _, v := range m.Layers {
+			switch v.MediaType {
+			case mediaTypeDockerForeignLayer, mediaTypeOCIImageLayerNonDistributable, mediaTypeOCIImageLayerNonDistributableGzip, mediaTypeOCIImageLayerNonDistributableZstd:
+				continue
+			}
+
+			if v.Digest == "" {
+				return nil, nil, fmt.Errorf("image manifest (%s) references a layer without a digest", mediaType)
+			}
+
+			blobs = append(blobs, v.Digest)
+		}
+
+		return nil, blobs, nil
+
+	default:
+		return nil, nil, fmt.Errorf("unsupported image manifest media type: %q", mediaType)
+	}
+}
@@ -0,0 +1,118 @@
+package ecr
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestImageManifestReferences(t *testing.T) {
+	testCases := []struct {
+		Name              string
+		MediaType         string
+		Manifest          string
+		ExpectedManifests []string
+		ExpectedBlobs     []string
+		ExpectError       *regexp.Regexp
+	}{
+		{
+			Name:      "docker manifest",
+			MediaType: mediaTypeDockerManifestSchema2,
+			Manifest: `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+  "config": {"mediaType": "application/vnd.docker.container.image.v1+json", "size": 1, "digest": "sha256:c0"},
+  "layers": [
+    {"mediaType": "application/vnd.docker.image.rootfs.diff.tar.gzip", "size": 1, "digest": "sha256:l1"},
+    {"mediaType": "application/vnd.docker.image.rootfs.foreign.diff.tar.gzip", "size": 1, "digest": "sha256:f1", "urls": ["https://example.com/f1"]},
+    {"mediaType": "application/vnd.docker.image.rootfs.diff.tar.gzip", "size": 1, "digest": "sha256:l2"}
+  ]
+}`,
+			ExpectedBlobs: []string{"sha256:c0", "sha256:l1", "sha256:l2"},
+		},
+		{
+			Name: "oci manifest without media type",
+			Manifest: `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.oci.image.manifest.v1+json",
+  "config": {"mediaType": "application/vnd.oci.image.config.v1+json", "size": 1, "digest": "sha256:c0"},
+  "layers": [
+    {"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "size": 1, "digest": "sha256:l1"}
+  ]
+}`,
+			ExpectedBlobs: []string{"sha256:c0", "sha256:l1"},
+		},
+		{
+			Name:      "docker manifest list",
+			MediaType: mediaTypeDockerManifestList,
+			Manifest: `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.docker.distribution.manifest.list.v2+json",
+  "manifests": [
+    {"mediaType": "application/vnd.docker.distribution.manifest.v2+json", "size": 1, "digest": "sha256:m1", "platform": {"architecture": "amd64", "os": "linux"}},
+    {"mediaType": "application/vnd.docker.distribution.manifest.v2+json", "size": 1, "digest": "sha256:m2", "platform": {"architecture": "arm64", "os": "linux"}}
+  ]
+}`,
+			ExpectedManifests: []string{"sha256:m1", "sha256:m2"},
+		},
+		{
+			Name:      "oci image index",
+			MediaType: mediaTypeOCIImageIndex,
+			Manifest: `{
+  "schemaVersion": 2,
+  "manifests": [
+    {"mediaType": "application/vnd.oci.image.manifest.v1+json", "size": 1, "digest": "sha256:m1"}
+  ]
+}`,
+			ExpectedManifests: []string{"sha256:m1"},
+		},
+		{
+			Name:        "manifest without config",
+			MediaType:   mediaTypeOCIImageManifest,
+			Manifest:    `{"schemaVersion": 2, "layers": []}`,
+			ExpectError: regexp.MustCompile(`has no config digest`),
+		},
+		{
+			Name:        "schema 1 manifest",
+			MediaType:   mediaTypeDockerManifestSchema1JWS,
+			Manifest:    `{"schemaVersion": 1, "fsLayers": [{"blobSum": "sha256:l1"}]}`,
+			ExpectError: regexp.MustCompile(`unsupported image manifest media type`),
+		},
+		{
+			Name:        "invalid json",
+			MediaType:   mediaTypeOCIImageManifest,
+			Manifest:    `{`,
+			ExpectError: regexp.MustCompile(`error parsing image manifest`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			manifests, blobs, err := imageManifestReferences(testCase.MediaType, testCase.Manifest)
+
+			if testCase.ExpectError != nil {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.ExpectError)
+				}
+
+				if !testCase.ExpectError.MatchString(err.Error()) {
+					t.Fatalf("expected error %q, got %q", testCase.ExpectError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(manifests, testCase.ExpectedManifests) {
+				t.Errorf("expected manifests %v, got %v", testCase.ExpectedManifests, manifests)
+			}
+
+			if !reflect.DeepEqual(blobs, testCase.ExpectedBlobs) {
+				t.Errorf("expected blobs %v, got %v", testCase.ExpectedBlobs, blobs)
+			}
+		})
+	}
+}
@@ -25,6 +25,7 @@ func DataSourceContainerDefinition() *schema.Resource {
 				Required: true,
 			},
 			// Computed values.
+			"container": taskDefinitionContainerDataSourceSchema(),
 			"image": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -103,6 +104,10 @@ func dataSourceContainerDefinitionRead(d *schema.ResourceData, meta interface{})
 			environment[aws.StringValue(keyValuePair.Name)] = aws.StringValue(keyValuePair.Value)
 		}
 		d.Set("environment", environment)
+
+		if err := d.Set("container", flattenTaskDefinitionContainers([]*ecs.ContainerDefinition{def})); err != nil {
+			return fmt.Errorf("error setting container: %w", err)
+		}
 	}
 
 	if d.Id() == "" {
@@ -30,6 +30,10 @@ func TestAccECSContainerDefinitionDataSource_ecsContainerDefinition(t *testing.T
 					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "memory_reservation", "64"),
 					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "cpu", "128"),
 					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "environment.SECRET", "KEY"),
+					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "container.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "container.0.name", "mongodb"),
+					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "container.0.image", "mongo:latest"),
+					resource.TestCheckResourceAttr("data.aws_ecs_container_definition.mongo", "container.0.environment.SECRET", "KEY"),
 				),
 			},
 		},
@@ -304,6 +304,99 @@ func ResourceService() *schema.Resource {
 				Default:      ecs.SchedulingStrategyReplica,
 				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
 			},
+			"service_connect_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_option": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value_from": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validSecretValueFrom,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_alias": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dns_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"discovery_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ingress_port_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"port_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -523,6 +616,10 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 		input.ServiceRegistries = srs
 	}
 
+	if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS()) // tags field doesn't exist in all partitions
 	}
@@ -553,7 +650,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	cluster := d.Get("cluster").(string)
 
 	if d.Get("wait_for_steady_state").(bool) {
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if _, err := waitServiceStable(conn, d.Id(), cluster); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after creation: %w", d.Id(), err)
 		}
 	} else {
@@ -723,6 +820,28 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting network_configuration for (%s): %w", d.Id(), err)
 	}
 
+	// The Service Connect configuration is only returned as part of the service's deployments.
+	// Removing the block disables Service Connect, so a disabled configuration is only kept when the block is configured.
+	var serviceConnectConfiguration *ecs.ServiceConnectConfiguration
+	if deployment := primaryDeployment(service.Deployments); deployment != nil {
+		serviceConnectConfiguration = deployment.ServiceConnectConfiguration
+	}
+
+	if serviceConnectConfiguration != nil && (aws.BoolValue(serviceConnectConfiguration.Enabled) || len(d.Get("service_connect_configuration").([]interface{})) > 0) {
+		tfMap := flattenServiceConnectConfiguration(serviceConnectConfiguration)
+
+		// Save namespace in the same format
+		if v, ok := d.GetOk("service_connect_configuration.0.namespace"); ok && !strings.HasPrefix(v.(string), "arn:") {
+			tfMap["namespace"] = v.(string)
+		}
+
+		if err := d.Set("service_connect_configuration", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting service_connect_configuration for (%s): %w", d.Id(), err)
+		}
+	} else {
+		d.Set("service_connect_configuration", nil)
+	}
+
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
 		return fmt.Errorf("error setting service_registries for (%s): %w", d.Id(), err)
 	}
@@ -795,6 +914,110 @@ func flattenDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker) ma
 	return tfMap
 }
 
+func expandServiceConnectConfiguration(tfMap map[string]interface{}) *ecs.ServiceConnectConfiguration {
+	apiObject := &ecs.ServiceConnectConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Services = expandServiceConnectServices(v)
+	}
+
+	return apiObject
+}
+
+func expandServiceConnectServices(tfList []interface{}) []*ecs.ServiceConnectService {
+	var apiObjects []*ecs.ServiceConnectService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceConnectService{
+			PortName: aws.String(tfMap["port_name"].(string)),
+		}
+
+		if v, ok := tfMap["client_alias"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				clientAlias := &ecs.ServiceConnectClientAlias{
+					Port: aws.Int64(int64(tfMap["port"].(int))),
+				}
+
+				if v, ok := tfMap["dns_name"].(string); ok && v != "" {
+					clientAlias.DnsName = aws.String(v)
+				}
+
+				apiObject.ClientAliases = append(apiObject.ClientAliases, clientAlias)
+			}
+		}
+
+		if v, ok := tfMap["discovery_name"].(string); ok && v != "" {
+			apiObject.DiscoveryName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingress_port_override"].(int); ok && v != 0 {
+			apiObject.IngressPortOverride = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenServiceConnectConfiguration(apiObject *ecs.ServiceConnectConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":           aws.BoolValue(apiObject.Enabled),
+		"log_configuration": flattenLogConfiguration(apiObject.LogConfiguration),
+		"namespace":         aws.StringValue(apiObject.Namespace),
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.Services {
+		var clientAliases []interface{}
+
+		for _, v := range v.ClientAliases {
+			clientAliases = append(clientAliases, map[string]interface{}{
+				"dns_name": aws.StringValue(v.DnsName),
+				"port":     aws.Int64Value(v.Port),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"client_alias":          clientAliases,
+			"discovery_name":        aws.StringValue(v.DiscoveryName),
+			"ingress_port_override": aws.Int64Value(v.IngressPortOverride),
+			"port_name":             aws.StringValue(v.PortName),
+		})
+	}
+
+	tfMap["service"] = tfList
+
+	return tfMap
+}
+
 func flattenNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -1081,6 +1304,17 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.PropagateTags = aws.String(d.Get("propagate_tags").(string))
 		}
 
+		if d.HasChange("service_connect_configuration") {
+			// To remove an existing Service Connect configuration, disable it.
+			input.ServiceConnectConfiguration = &ecs.ServiceConnectConfiguration{
+				Enabled: aws.Bool(false),
+			}
+
+			if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.ServiceConnectConfiguration = expandServiceConnectConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			}
+		}
+
 		if d.HasChange("service_registries") {
 			input.ServiceRegistries = expandServiceRegistries(d.Get("service_registries").([]interface{}))
 		}
@@ -1114,7 +1348,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 		cluster := d.Get("cluster").(string)
 		if d.Get("wait_for_steady_state").(bool) {
-			if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+			if _, err := waitServiceStable(conn, d.Id(), cluster); err != nil {
 				return fmt.Errorf("error waiting for ECS service (%s) to reach steady state after update: %w", d.Id(), err)
 			}
 		} else {
@@ -1284,3 +1518,14 @@ func buildFamilyAndRevisionFromARN(arn string) string {
 func getNameFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
+
+// primaryDeployment returns the deployment of a service that is rolling out its most recent configuration.
+func primaryDeployment(deployments []*ecs.Deployment) *ecs.Deployment {
+	for _, v := range deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,8 @@ const (
 	serviceStatusError = "ERROR"
 	serviceStatusNone  = "NONE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	clusterStatusError = "ERROR"
 	clusterStatusNone  = "NONE"
 
@@ -86,6 +89,46 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+// statusServiceStability returns the service and whether it has reached a steady state:
+// a single deployment, running the desired number of tasks.
+// A service that is missing, draining or inactive never reaches a steady state and is reported as an error.
+func statusServiceStability(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &ecs.DescribeServicesInput{
+			Services: aws.StringSlice([]string{id}),
+		}
+
+		if cluster != "" {
+			input.Cluster = aws.String(cluster)
+		}
+
+		output, err := conn.DescribeServices(input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+			return nil, "", &resource.NotFoundError{
+				Message:     "service not found",
+				LastRequest: input,
+			}
+		}
+
+		service := output.Services[0]
+
+		if status := aws.StringValue(service.Status); status == serviceStatusDraining || status == serviceStatusInactive {
+			return service, "", fmt.Errorf("service status is %s", status)
+		}
+
+		if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+			return service, ecs.StabilityStatusSteadyState, nil
+		}
+
+		return service, ecs.StabilityStatusStabilizing, nil
+	}
+}
+
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -49,7 +50,10 @@ func ResourceTaskDefinition() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceTaskDefinitionContainerCustomizeDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -59,10 +63,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container": taskDefinitionContainerSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -434,15 +441,18 @@ func resourceTaskDefinitionCreate(d *schema.ResourceData, meta interface{}) erro
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return err
+	input := ecs.RegisterTaskDefinitionInput{
+		Family: aws.String(d.Get("family").(string)),
 	}
 
-	input := ecs.RegisterTaskDefinitionInput{
-		ContainerDefinitions: definitions,
-		Family:               aws.String(d.Get("family").(string)),
+	if v, ok := d.GetOk("container"); ok && len(v.([]interface{})) > 0 {
+		input.ContainerDefinitions = expandTaskDefinitionContainers(v.([]interface{}))
+	} else {
+		definitions, err := expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return err
+		}
+		input.ContainerDefinitions = definitions
 	}
 
 	// ClientException: Tags can not be empty.
@@ -611,6 +621,14 @@ func resourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// Only track the typed container blocks when they are configured, otherwise
+	// configurations using container_definitions would show them as removed.
+	if v, ok := d.GetOk("container"); ok && len(v.([]interface{})) > 0 {
+		if err := d.Set("container", flattenTaskDefinitionContainers(taskDefinition.ContainerDefinitions)); err != nil {
+			return fmt.Errorf("error setting container: %w", err)
+		}
+	}
+
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
 	d.Set("cpu", taskDefinition.Cpu)
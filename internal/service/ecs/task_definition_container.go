@@ -0,0 +1,1133 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// taskDefinitionContainerSchema returns the schema of the typed alternative to container_definitions.
+// Attribute names match those of the aws_ecs_container_definition data source where the two overlap.
+func taskDefinitionContainerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"dependency": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"disable_networking": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"docker_labels": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment_file": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"type": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.EnvironmentFileTypeS3,
+								ValidateFunc: validation.StringInSlice(ecs.EnvironmentFileType_Values(), false),
+							},
+							"value": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"hostname": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"interactive": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"links": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem:     taskDefinitionContainerSecretResource(),
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"container_port_range": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							// In awsvpc network mode, ECS sets the host port to the container port.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"pseudo_terminal": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"repository_credentials": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"credentials_parameter": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: verify.ValidARN,
+							},
+						},
+					},
+				},
+				"secret": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     taskDefinitionContainerSecretResource(),
+				},
+				"start_timeout": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					ForceNew: true,
+				},
+				"stop_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 120),
+				},
+				"ulimit": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hard_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							"name": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.UlimitName_Values(), false),
+							},
+							"soft_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"volumes_from": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_container": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func taskDefinitionContainerSecretResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value_from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validSecretValueFrom,
+			},
+		},
+	}
+}
+
+// taskDefinitionContainerDataSourceSchema returns the computed counterpart of taskDefinitionContainerSchema
+// so that data sources can expose container definitions in a form that can be assigned to the container argument.
+func taskDefinitionContainerDataSourceSchema() *schema.Schema {
+	v := taskDefinitionContainerSchema()
+
+	taskDefinitionContainerComputedSchema(v)
+
+	return v
+}
+
+func taskDefinitionContainerComputedSchema(v *schema.Schema) {
+	v.Computed = true
+	v.ConflictsWith = nil
+	v.Default = nil
+	v.ExactlyOneOf = nil
+	v.ForceNew = false
+	v.MaxItems = 0
+	v.MinItems = 0
+	v.Optional = false
+	v.Required = false
+	v.RequiredWith = nil
+	v.ValidateFunc = nil
+
+	if elem, ok := v.Elem.(*schema.Resource); ok {
+		for _, v := range elem.Schema {
+			taskDefinitionContainerComputedSchema(v)
+		}
+	}
+}
+
+func resourceTaskDefinitionContainerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("container")
+
+	if !ok || len(v.([]interface{})) == 0 {
+		return nil
+	}
+
+	// Unknown values are read as zero values, which the checks below skip.
+	var cpu, memory, networkMode string
+
+	if d.NewValueKnown("cpu") {
+		cpu = d.Get("cpu").(string)
+	}
+
+	if d.NewValueKnown("memory") {
+		memory = d.Get("memory").(string)
+	}
+
+	if d.NewValueKnown("network_mode") {
+		networkMode = d.Get("network_mode").(string)
+	}
+
+	return validTaskDefinitionContainers(expandTaskDefinitionContainers(v.([]interface{})), cpu, memory, networkMode)
+}
+
+// validTaskDefinitionContainers checks the container definitions of a task definition
+// against each other and against the task size, catching errors that ECS would otherwise
+// only report when the task definition is registered.
+func validTaskDefinitionContainers(containers []*ecs.ContainerDefinition, cpu, memory, networkMode string) error {
+	var errs *multierror.Error
+
+	names := make(map[string]bool)
+	portMappingNames := make(map[string]bool)
+	hostPorts := make(map[string]string)
+	var cpuSum, memorySum int64
+	var unknownName bool
+
+	for _, c := range containers {
+		name := aws.StringValue(c.Name)
+
+		if name == "" {
+			unknownName = true
+		} else {
+			if names[name] {
+				errs = multierror.Append(errs, fmt.Errorf("duplicate container name: %s", name))
+			}
+			names[name] = true
+		}
+
+		cpuSum += aws.Int64Value(c.Cpu)
+
+		hardLimit, softLimit := aws.Int64Value(c.Memory), aws.Int64Value(c.MemoryReservation)
+
+		if hardLimit > 0 && softLimit > hardLimit {
+			errs = multierror.Append(errs, fmt.Errorf("container (%s): memory_reservation (%d) must not exceed memory (%d)", name, softLimit, hardLimit))
+		}
+
+		if hardLimit > 0 {
+			memorySum += hardLimit
+		} else {
+			memorySum += softLimit
+		}
+
+		for _, pm := range c.PortMappings {
+			containerPort, hostPort := aws.Int64Value(pm.ContainerPort), aws.Int64Value(pm.HostPort)
+
+			if containerPort > 0 && aws.StringValue(pm.ContainerPortRange) != "" {
+				errs = multierror.Append(errs, fmt.Errorf("container (%s): port_mapping cannot specify both container_port and container_port_range", name))
+			}
+
+			if networkMode == ecs.NetworkModeAwsvpc && hostPort > 0 && containerPort > 0 && hostPort != containerPort {
+				errs = multierror.Append(errs, fmt.Errorf("container (%s): port_mapping host_port (%d) must equal container_port (%d) in %s network mode", name, hostPort, containerPort, networkMode))
+			}
+
+			if networkMode == ecs.NetworkModeAwsvpc || networkMode == ecs.NetworkModeHost {
+				hostPort = containerPort
+			}
+
+			if hostPort > 0 {
+				key := fmt.Sprintf("%d/%s", hostPort, aws.StringValue(pm.Protocol))
+
+				if other, ok := hostPorts[key]; ok {
+					errs = multierror.Append(errs, fmt.Errorf("container (%s): host port %s is already mapped by container (%s)", name, key, other))
+				}
+				hostPorts[key] = name
+			}
+
+			if v := aws.StringValue(pm.Name); v != "" {
+				if portMappingNames[v] {
+					errs = multierror.Append(errs, fmt.Errorf("container (%s): duplicate port_mapping name: %s", name, v))
+				}
+				portMappingNames[v] = true
+			}
+		}
+	}
+
+	// A container whose name is not yet known may be the target of any dependency.
+	if !unknownName {
+		for _, c := range containers {
+			for _, v := range c.DependsOn {
+				if dependency := aws.StringValue(v.ContainerName); dependency != "" && !names[dependency] {
+					errs = multierror.Append(errs, fmt.Errorf("container (%s): dependency on unknown container: %s", aws.StringValue(c.Name), dependency))
+				}
+			}
+		}
+	}
+
+	if v, ok := taskDefinitionCPUUnits(cpu); ok && cpuSum > v {
+		errs = multierror.Append(errs, fmt.Errorf("sum of container cpu (%d) exceeds task cpu (%d)", cpuSum, v))
+	}
+
+	if v, ok := taskDefinitionMemoryMiB(memory); ok && memorySum > v {
+		errs = multierror.Append(errs, fmt.Errorf("sum of container memory (%d MiB) exceeds task memory (%d MiB)", memorySum, v))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// taskDefinitionCPUUnits parses a task CPU size, either in CPU units (e.g. "1024") or vCPUs (e.g. "1 vCPU").
+func taskDefinitionCPUUnits(s string) (int64, bool) {
+	return parseTaskDefinitionSize(s, "vcpu", 1024)
+}
+
+// taskDefinitionMemoryMiB parses a task memory size, either in MiB (e.g. "2048") or GB (e.g. "2 GB").
+func taskDefinitionMemoryMiB(s string) (int64, bool) {
+	return parseTaskDefinitionSize(s, "gb", 1024)
+}
+
+func parseTaskDefinitionSize(s, unit string, multiplier float64) (int64, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	if s == "" {
+		return 0, false
+	}
+
+	if strings.HasSuffix(s, unit) {
+		v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, unit)), 64)
+
+		if err != nil {
+			return 0, false
+		}
+
+		return int64(v * multiplier), true
+	}
+
+	v, err := strconv.ParseInt(s, 10, 64)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return v, true
+}
+
+func expandTaskDefinitionContainers(tfList []interface{}) []*ecs.ContainerDefinition {
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["dependency"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandTaskDefinitionContainerDependencies(v)
+		}
+
+		if v, ok := tfMap["disable_networking"].(bool); ok && v {
+			apiObject.DisableNetworking = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DockerLabels = flex.ExpandStringMap(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.Environment = expandTaskDefinitionContainerEnvironment(v)
+		}
+
+		if v, ok := tfMap["environment_file"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EnvironmentFiles = expandTaskDefinitionContainerEnvironmentFiles(v)
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandTaskDefinitionContainerHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["hostname"].(string); ok && v != "" {
+			apiObject.Hostname = aws.String(v)
+		}
+
+		if v, ok := tfMap["interactive"].(bool); ok && v {
+			apiObject.Interactive = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["links"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Links = flex.ExpandStringList(v)
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandLogConfiguration(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MountPoints = expandTaskDefinitionContainerMountPoints(v)
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+			apiObject.PortMappings = expandTaskDefinitionContainerPortMappings(v)
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["pseudo_terminal"].(bool); ok && v {
+			apiObject.PseudoTerminal = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["repository_credentials"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.RepositoryCredentials = &ecs.RepositoryCredentials{
+				CredentialsParameter: aws.String(v[0].(map[string]interface{})["credentials_parameter"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Secrets = expandSecrets(v)
+		}
+
+		if v, ok := tfMap["start_timeout"].(int); ok && v != 0 {
+			apiObject.StartTimeout = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+			apiObject.StopTimeout = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Ulimits = expandTaskDefinitionContainerUlimits(v)
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["volumes_from"].([]interface{}); ok && len(v) > 0 {
+			apiObject.VolumesFrom = expandTaskDefinitionContainerVolumesFrom(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerDependencies(tfList []interface{}) []*ecs.ContainerDependency {
+	var apiObjects []*ecs.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerEnvironment(tfMap map[string]interface{}) []*ecs.KeyValuePair {
+	var apiObjects []*ecs.KeyValuePair
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.StringValue(apiObjects[i].Name) < aws.StringValue(apiObjects[j].Name)
+	})
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerEnvironmentFiles(tfList []interface{}) []*ecs.EnvironmentFile {
+	var apiObjects []*ecs.EnvironmentFile
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.EnvironmentFile{
+			Type:  aws.String(tfMap["type"].(string)),
+			Value: aws.String(tfMap["value"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command: flex.ExpandStringList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap["interval"].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timeout"].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+		apiObject.SecretOptions = expandSecrets(v)
+	}
+
+	return apiObject
+}
+
+func expandTaskDefinitionContainerMountPoints(tfList []interface{}) []*ecs.MountPoint {
+	var apiObjects []*ecs.MountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			Protocol: aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+			apiObject.ContainerPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["container_port_range"].(string); ok && v != "" {
+			apiObject.ContainerPortRange = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSecrets(tfList []interface{}) []*ecs.Secret {
+	var apiObjects []*ecs.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Secret{
+			Name:      aws.String(tfMap["name"].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerUlimits(tfList []interface{}) []*ecs.Ulimit {
+	var apiObjects []*ecs.Ulimit
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.Ulimit{
+			HardLimit: aws.Int64(int64(tfMap["hard_limit"].(int))),
+			Name:      aws.String(tfMap["name"].(string)),
+			SoftLimit: aws.Int64(int64(tfMap["soft_limit"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerVolumesFrom(tfList []interface{}) []*ecs.VolumeFrom {
+	var apiObjects []*ecs.VolumeFrom
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.VolumeFrom{
+			ReadOnly:        aws.Bool(tfMap["read_only"].(bool)),
+			SourceContainer: aws.String(tfMap["source_container"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenTaskDefinitionContainers(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"command":                  aws.StringValueSlice(apiObject.Command),
+			"cpu":                      aws.Int64Value(apiObject.Cpu),
+			"dependency":               flattenTaskDefinitionContainerDependencies(apiObject.DependsOn),
+			"disable_networking":       aws.BoolValue(apiObject.DisableNetworking),
+			"docker_labels":            aws.StringValueMap(apiObject.DockerLabels),
+			"entry_point":              aws.StringValueSlice(apiObject.EntryPoint),
+			"environment":              flattenTaskDefinitionContainerEnvironment(apiObject.Environment),
+			"environment_file":         flattenTaskDefinitionContainerEnvironmentFiles(apiObject.EnvironmentFiles),
+			"essential":                aws.BoolValue(apiObject.Essential),
+			"health_check":             flattenTaskDefinitionContainerHealthCheck(apiObject.HealthCheck),
+			"hostname":                 aws.StringValue(apiObject.Hostname),
+			"image":                    aws.StringValue(apiObject.Image),
+			"interactive":              aws.BoolValue(apiObject.Interactive),
+			"links":                    aws.StringValueSlice(apiObject.Links),
+			"log_configuration":        flattenLogConfiguration(apiObject.LogConfiguration),
+			"memory":                   aws.Int64Value(apiObject.Memory),
+			"memory_reservation":       aws.Int64Value(apiObject.MemoryReservation),
+			"mount_point":              flattenTaskDefinitionContainerMountPoints(apiObject.MountPoints),
+			"name":                     aws.StringValue(apiObject.Name),
+			"port_mapping":             flattenTaskDefinitionContainerPortMappings(apiObject.PortMappings),
+			"privileged":               aws.BoolValue(apiObject.Privileged),
+			"pseudo_terminal":          aws.BoolValue(apiObject.PseudoTerminal),
+			"readonly_root_filesystem": aws.BoolValue(apiObject.ReadonlyRootFilesystem),
+			"secret":                   flattenSecrets(apiObject.Secrets),
+			"start_timeout":            aws.Int64Value(apiObject.StartTimeout),
+			"stop_timeout":             aws.Int64Value(apiObject.StopTimeout),
+			"ulimit":                   flattenTaskDefinitionContainerUlimits(apiObject.Ulimits),
+			"user":                     aws.StringValue(apiObject.User),
+			"volumes_from":             flattenTaskDefinitionContainerVolumesFrom(apiObject.VolumesFrom),
+			"working_directory":        aws.StringValue(apiObject.WorkingDirectory),
+		}
+
+		if v := apiObject.RepositoryCredentials; v != nil {
+			tfMap["repository_credentials"] = []interface{}{map[string]interface{}{
+				"credentials_parameter": aws.StringValue(v.CredentialsParameter),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerDependencies(apiObjects []*ecs.ContainerDependency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"condition":      aws.StringValue(apiObject.Condition),
+			"container_name": aws.StringValue(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerEnvironment(apiObjects []*ecs.KeyValuePair) map[string]string {
+	tfMap := make(map[string]string)
+
+	for _, apiObject := range apiObjects {
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenTaskDefinitionContainerEnvironmentFiles(apiObjects []*ecs.EnvironmentFile) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"type":  aws.StringValue(apiObject.Type),
+			"value": aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerHealthCheck(apiObject *ecs.HealthCheck) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"command":      aws.StringValueSlice(apiObject.Command),
+		"interval":     aws.Int64Value(apiObject.Interval),
+		"retries":      aws.Int64Value(apiObject.Retries),
+		"start_period": aws.Int64Value(apiObject.StartPeriod),
+		"timeout":      aws.Int64Value(apiObject.Timeout),
+	}}
+}
+
+func flattenLogConfiguration(apiObject *ecs.LogConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"log_driver":    aws.StringValue(apiObject.LogDriver),
+		"options":       aws.StringValueMap(apiObject.Options),
+		"secret_option": flattenSecrets(apiObject.SecretOptions),
+	}}
+}
+
+func flattenTaskDefinitionContainerMountPoints(apiObjects []*ecs.MountPoint) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"container_path": aws.StringValue(apiObject.ContainerPath),
+			"read_only":      aws.BoolValue(apiObject.ReadOnly),
+			"source_volume":  aws.StringValue(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerPortMappings(apiObjects []*ecs.PortMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"app_protocol":         aws.StringValue(apiObject.AppProtocol),
+			"container_port":       aws.Int64Value(apiObject.ContainerPort),
+			"container_port_range": aws.StringValue(apiObject.ContainerPortRange),
+			"host_port":            aws.Int64Value(apiObject.HostPort),
+			"name":                 aws.StringValue(apiObject.Name),
+			"protocol":             aws.StringValue(apiObject.Protocol),
+		})
+	}
+
+	return tfList
+}
+
+func flattenSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerUlimits(apiObjects []*ecs.Ulimit) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"hard_limit": aws.Int64Value(apiObject.HardLimit),
+			"name":       aws.StringValue(apiObject.Name),
+			"soft_limit": aws.Int64Value(apiObject.SoftLimit),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerVolumesFrom(apiObjects []*ecs.VolumeFrom) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"read_only":        aws.BoolValue(apiObject.ReadOnly),
+			"source_container": aws.StringValue(apiObject.SourceContainer),
+		})
+	}
+
+	return tfList
+}
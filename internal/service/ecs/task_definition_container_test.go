@@ -0,0 +1,300 @@
+package ecs
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidTaskDefinitionContainers(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Containers  []*ecs.ContainerDefinition
+		CPU         string
+		Memory      string
+		NetworkMode string
+		ExpectError *regexp.Regexp
+	}{
+		{
+			Name: "valid",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name:   aws.String("web"),
+					Cpu:    aws.Int64(128),
+					Memory: aws.Int64(256),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String("tcp"), Name: aws.String("http")},
+					},
+					DependsOn: []*ecs.ContainerDependency{
+						{ContainerName: aws.String("sidecar"), Condition: aws.String(ecs.ContainerConditionStart)},
+					},
+				},
+				{
+					Name:              aws.String("sidecar"),
+					Cpu:               aws.Int64(128),
+					MemoryReservation: aws.Int64(256),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(9000), Protocol: aws.String("tcp")},
+					},
+				},
+			},
+			CPU:         "256",
+			Memory:      "512",
+			NetworkMode: ecs.NetworkModeAwsvpc,
+		},
+		{
+			Name: "task size in vCPU and GB",
+			Containers: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Cpu: aws.Int64(1024), Memory: aws.Int64(2048)},
+			},
+			CPU:    "1 vCPU",
+			Memory: "2 GB",
+		},
+		{
+			Name: "unknown task size",
+			Containers: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Cpu: aws.Int64(4096), Memory: aws.Int64(8192)},
+			},
+		},
+		{
+			Name: "cpu exceeds task cpu",
+			Containers: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Cpu: aws.Int64(256)},
+				{Name: aws.String("sidecar"), Cpu: aws.Int64(256)},
+			},
+			CPU:         "0.25 vCPU",
+			ExpectError: regexp.MustCompile(`sum of container cpu \(512\) exceeds task cpu \(256\)`),
+		},
+		{
+			Name: "memory exceeds task memory",
+			Containers: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Memory: aws.Int64(384)},
+				{Name: aws.String("sidecar"), MemoryReservation: aws.Int64(256)},
+			},
+			Memory:      "512",
+			ExpectError: regexp.MustCompile(`sum of container memory \(640 MiB\) exceeds task memory \(512 MiB\)`),
+		},
+		{
+			Name: "memory reservation exceeds memory",
+			Containers: []*ecs.ContainerDefinition{
+				{Name: aws.String("web"), Memory: aws.Int64(128), MemoryReservation: aws.Int64(256)},
+			},
+			ExpectError: regexp.MustCompile(`memory_reservation \(256\) must not exceed memory \(128\)`),
+		},
+		{
+			Name: "duplicate container name",
+			Containers: []*ecs.ContainerDefinition{
+				{Name: aws.String("web")},
+				{Name: aws.String("web")},
+			},
+			ExpectError: regexp.MustCompile(`duplicate container name: web`),
+		},
+		{
+			Name: "awsvpc host port differs from container port",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080), Protocol: aws.String("tcp")},
+					},
+				},
+			},
+			NetworkMode: ecs.NetworkModeAwsvpc,
+			ExpectError: regexp.MustCompile(`host_port \(8080\) must equal container_port \(80\)`),
+		},
+		{
+			Name: "bridge host port differs from container port",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), HostPort: aws.Int64(8080), Protocol: aws.String("tcp")},
+					},
+				},
+			},
+			NetworkMode: ecs.NetworkModeBridge,
+		},
+		{
+			Name: "awsvpc duplicate container port",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), Protocol: aws.String("tcp")},
+					},
+				},
+				{
+					Name: aws.String("sidecar"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), Protocol: aws.String("tcp")},
+					},
+				},
+			},
+			NetworkMode: ecs.NetworkModeAwsvpc,
+			ExpectError: regexp.MustCompile(`host port 80/tcp is already mapped by container \(web\)`),
+		},
+		{
+			Name: "bridge dynamic host ports",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), Protocol: aws.String("tcp")},
+					},
+				},
+				{
+					Name: aws.String("sidecar"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), Protocol: aws.String("tcp")},
+					},
+				},
+			},
+			NetworkMode: ecs.NetworkModeBridge,
+		},
+		{
+			Name: "duplicate port mapping name",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), Protocol: aws.String("tcp"), Name: aws.String("http")},
+						{ContainerPort: aws.Int64(8080), Protocol: aws.String("tcp"), Name: aws.String("http")},
+					},
+				},
+			},
+			ExpectError: regexp.MustCompile(`duplicate port_mapping name: http`),
+		},
+		{
+			Name: "container port and range",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					PortMappings: []*ecs.PortMapping{
+						{ContainerPort: aws.Int64(80), ContainerPortRange: aws.String("8000-8010"), Protocol: aws.String("tcp")},
+					},
+				},
+			},
+			ExpectError: regexp.MustCompile(`cannot specify both container_port and container_port_range`),
+		},
+		{
+			Name: "dependency on unknown container",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					DependsOn: []*ecs.ContainerDependency{
+						{ContainerName: aws.String("sidecar"), Condition: aws.String(ecs.ContainerConditionStart)},
+					},
+				},
+			},
+			ExpectError: regexp.MustCompile(`dependency on unknown container: sidecar`),
+		},
+		{
+			Name: "dependency with unknown container name",
+			Containers: []*ecs.ContainerDefinition{
+				{
+					Name: aws.String("web"),
+					DependsOn: []*ecs.ContainerDependency{
+						{ContainerName: aws.String("sidecar"), Condition: aws.String(ecs.ContainerConditionStart)},
+					},
+				},
+				{
+					Name: aws.String(""),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			err := validTaskDefinitionContainers(testCase.Containers, testCase.CPU, testCase.Memory, testCase.NetworkMode)
+
+			if err == nil && testCase.ExpectError != nil {
+				t.Fatalf("expected error %q, got none", testCase.ExpectError)
+			}
+
+			if err != nil && testCase.ExpectError == nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectError.MatchString(err.Error()) {
+				t.Fatalf("expected error %q, got %q", testCase.ExpectError, err)
+			}
+		})
+	}
+}
+
+func TestTaskDefinitionContainersRoundTrip(t *testing.T) {
+	apiObjects := []*ecs.ContainerDefinition{
+		{
+			Name:      aws.String("web"),
+			Image:     aws.String("nginx:latest"),
+			Essential: aws.Bool(true),
+			Cpu:       aws.Int64(128),
+			Memory:    aws.Int64(256),
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("A"), Value: aws.String("1")},
+				{Name: aws.String("B"), Value: aws.String("2")},
+			},
+			PortMappings: []*ecs.PortMapping{
+				{ContainerPort: aws.Int64(80), HostPort: aws.Int64(80), Protocol: aws.String("tcp")},
+			},
+			Secrets: []*ecs.Secret{
+				{Name: aws.String("PASSWORD"), ValueFrom: aws.String("/example/password")},
+			},
+			LogConfiguration: &ecs.LogConfiguration{
+				LogDriver: aws.String(ecs.LogDriverAwslogs),
+				Options:   aws.StringMap(map[string]string{"awslogs-group": "example"}),
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"container": taskDefinitionContainerSchema()}, nil)
+
+	if err := d.Set("container", flattenTaskDefinitionContainers(apiObjects)); err != nil {
+		t.Fatalf("error setting container: %s", err)
+	}
+
+	got := expandTaskDefinitionContainers(d.Get("container").([]interface{}))
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 container, got %d", len(got))
+	}
+
+	if got, want := got[0].String(), apiObjects[0].String(); got != want {
+		t.Errorf("round trip mismatch:\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestTaskDefinitionContainerDataSourceSchema(t *testing.T) {
+	var check func(string, *schema.Schema)
+
+	check = func(k string, v *schema.Schema) {
+		if !v.Computed || v.Optional || v.Required || v.ForceNew {
+			t.Errorf("%s: expected computed only, got Computed=%t Optional=%t Required=%t ForceNew=%t", k, v.Computed, v.Optional, v.Required, v.ForceNew)
+		}
+
+		if v.ValidateFunc != nil || v.Default != nil || v.MaxItems != 0 || len(v.ExactlyOneOf) > 0 {
+			t.Errorf("%s: expected no configuration constraints", k)
+		}
+
+		if elem, ok := v.Elem.(*schema.Resource); ok {
+			for name, v := range elem.Schema {
+				check(k+"."+name, v)
+			}
+		}
+	}
+
+	check("container", taskDefinitionContainerDataSourceSchema())
+
+	// The resource schema must not be affected.
+	if v := taskDefinitionContainerSchema(); !v.Optional || v.Computed {
+		t.Errorf("container: resource schema modified")
+	}
+
+	if err := schema.InternalMap(map[string]*schema.Schema{"container": taskDefinitionContainerDataSourceSchema()}).InternalValidate(nil); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -24,6 +24,7 @@ func DataSourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container": taskDefinitionContainerDataSourceSchema(),
 			"family": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -69,6 +70,9 @@ func dataSourceTaskDefinitionRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(aws.StringValue(taskDefinition.TaskDefinitionArn))
 	d.Set("arn", taskDefinition.TaskDefinitionArn)
+	if err := d.Set("container", flattenTaskDefinitionContainers(taskDefinition.ContainerDefinitions)); err != nil {
+		return fmt.Errorf("error setting container: %w", err)
+	}
 	d.Set("family", taskDefinition.Family)
 	d.Set("network_mode", taskDefinition.NetworkMode)
 	d.Set("revision", taskDefinition.Revision)
@@ -29,6 +29,36 @@ func TestAccECSTaskDefinitionDataSource_ecsTaskDefinition(t *testing.T) {
 					resource.TestMatchResourceAttr(resourceName, "revision", regexp.MustCompile("^[1-9][0-9]*$")),
 					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
 					resource.TestCheckResourceAttrPair(resourceName, "task_role_arn", "aws_iam_role.mongo_role", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.name", "mongodb"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", "mongo:latest"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.cpu", "128"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.memory", "128"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.memory_reservation", "64"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.SECRET", "KEY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinitionDataSource_container(t *testing.T) {
+	dataSourceName := "data.aws_ecs_task_definition.mongo"
+	resourceName := "aws_ecs_task_definition.copy"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(5))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTaskDefinitionDataSourceContainerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "container.#", dataSourceName, "container.#"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.name", "mongodb"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.image", "mongo:latest"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.SECRET", "KEY"),
 				),
 			},
 		},
@@ -87,3 +117,26 @@ data "aws_ecs_task_definition" "mongo" {
 }
 `, rName)
 }
+
+func testAccCheckTaskDefinitionDataSourceContainerConfig(rName string) string {
+	return acctest.ConfigCompose(testAccCheckTaskDefinitionDataSourceConfig(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "copy" {
+  family       = "%[1]s-copy"
+  network_mode = "bridge"
+
+  dynamic "container" {
+    for_each = data.aws_ecs_task_definition.mongo.container
+
+    content {
+      cpu                = container.value.cpu
+      environment        = container.value.environment
+      essential          = container.value.essential
+      image              = container.value.image
+      memory             = container.value.memory
+      memory_reservation = container.value.memory_reservation
+      name               = container.value.name
+    }
+  }
+}
+`, rName))
+}
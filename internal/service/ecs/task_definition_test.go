@@ -1118,6 +1118,66 @@ func testAccCheckTaskDefinitionConstraintsAttrs(def *ecs.TaskDefinition) resourc
 	}
 }
 
+func TestAccECSTaskDefinition_container(t *testing.T) {
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionContainerConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.environment.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.host_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container.0.secret.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "container.0.secret.0.value_from", "aws_ssm_parameter.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "container.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				Config:   testAccTaskDefinitionContainerConfig(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_Container_validation(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ecs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionContainerCPUExceededConfig(rName),
+				ExpectError: regexp.MustCompile(`sum of container cpu \(512\) exceeds task cpu \(256\)`),
+			},
+			{
+				Config:      testAccTaskDefinitionContainerSecretInvalidConfig(rName),
+				ExpectError: regexp.MustCompile(`must be a Secrets Manager secret ARN or an SSM parameter ARN`),
+			},
+		},
+	})
+}
+
 func TestValidTaskDefinitionContainerDefinitions(t *testing.T) {
 	validDefinitions := []string{
 		testValidTaskDefinitionValidContainerDefinitions,
@@ -2615,3 +2675,119 @@ resource "aws_fsx_windows_file_system" "test" {
 }
 `)
 }
+
+func testAccTaskDefinitionContainerConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "SecureString"
+  value = "test"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ecs-tasks.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  execution_role_arn       = aws_iam_role.test.arn
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 128
+    memory = 256
+
+    environment = {
+      B = "2"
+      A = "1"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    secret {
+      name       = "PASSWORD"
+      value_from = aws_ssm_parameter.test.arn
+    }
+
+    dependency {
+      container_name = "sidecar"
+      condition      = "START"
+    }
+  }
+
+  container {
+    name      = "sidecar"
+    image     = "busybox"
+    essential = false
+    command   = ["sleep", "360"]
+    cpu       = 128
+    memory    = 128
+  }
+}
+`, rName)
+}
+
+func testAccTaskDefinitionContainerCPUExceededConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 256
+    memory = 256
+  }
+
+  container {
+    name   = "sidecar"
+    image  = "busybox"
+    cpu    = 256
+    memory = 128
+  }
+}
+`, rName)
+}
+
+func testAccTaskDefinitionContainerSecretInvalidConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container {
+    name   = "web"
+    image  = "nginx:latest"
+    memory = 256
+
+    secret {
+      name       = "PASSWORD"
+      value_from = "arn:aws:s3:::%[1]s/password"
+    }
+  }
+}
+`, rName)
+}
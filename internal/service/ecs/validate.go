@@ -3,7 +3,9 @@ package ecs
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -59,3 +61,32 @@ func validPlacementStrategy(stratType, stratField string) error {
 	}
 	return nil
 }
+
+// Validates the source of a container secret: the ARN of a Secrets Manager secret or
+// SSM parameter, or the name of an SSM parameter in the task's Region.
+func validSecretValueFrom(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !strings.HasPrefix(value, "arn:") {
+		return validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z0-9_.\-/]+$`),
+			"must be a Secrets Manager secret ARN, an SSM parameter ARN or an SSM parameter name",
+		)(v, k)
+	}
+
+	parsedARN, err := arn.Parse(value)
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	switch {
+	case parsedARN.Service == "secretsmanager" && strings.HasPrefix(parsedARN.Resource, "secret:"):
+	case parsedARN.Service == "ssm" && strings.HasPrefix(parsedARN.Resource, "parameter/"):
+	default:
+		errors = append(errors, fmt.Errorf("%q (%s) must be a Secrets Manager secret ARN or an SSM parameter ARN", k, value))
+	}
+
+	return
+}
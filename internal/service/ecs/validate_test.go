@@ -86,3 +86,34 @@ func TestValidPlacementStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidSecretValueFrom(t *testing.T) {
+	validValues := []string{
+		"arn:aws:secretsmanager:us-west-2:123456789012:secret:example-AbCdEf",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws:secretsmanager:us-west-2:123456789012:secret:example-AbCdEf:password::", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ssm:us-west-2:123456789012:parameter/example/password",                  //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:secretsmanager:us-gov-west-1:123456789012:secret:example-AbCdEf", //lintignore:AWSAT003,AWSAT005
+		"example-password",
+		"/example/password",
+	}
+	for _, v := range validValues {
+		_, errors := validSecretValueFrom(v, "value_from")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid secret value_from: %q", v, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"arn:aws:s3:::example-bucket/password",                                        //lintignore:AWSAT005
+		"arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:ssm:us-west-2:123456789012:document/example",                         //lintignore:AWSAT003,AWSAT005
+		"arn:aws:secretsmanager",
+		"example password",
+	}
+	for _, v := range invalidValues {
+		_, errors := validSecretValueFrom(v, "value_from")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid secret value_from", v)
+		}
+	}
+}
@@ -2,11 +2,16 @@ package ecs
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -18,6 +23,10 @@ const (
 	serviceInactiveTimeoutMin = 1 * time.Second
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
+	serviceStableTimeout      = 10 * time.Minute
+	serviceStableMinTimeout   = 15 * time.Second
+
+	serviceEventsSummaryCount = 3
 
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
@@ -62,19 +71,105 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+// waitServiceStable waits for a service to reach a steady state, logging the progress of its
+// primary deployment and new service events as they occur.
+// If the deployment circuit breaker marks the deployment as failed, it returns immediately.
+func waitServiceStable(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	var deploymentID, lastEventID string
+	refresh := statusServiceStability(conn, id, cluster)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ecs.StabilityStatusStabilizing},
+		Target:  []string{ecs.StabilityStatusSteadyState},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if err != nil || outputRaw == nil {
+				return outputRaw, status, err
+			}
+
+			service := outputRaw.(*ecs.Service)
+			lastEventID = logServiceEvents(id, service.Events, lastEventID)
+
+			// Track the deployment that was primary when waiting started, even once a rollback replaces it.
+			if deploymentID == "" {
+				if v := primaryDeployment(service.Deployments); v != nil {
+					deploymentID = aws.StringValue(v.Id)
+				}
+			}
+
+			for _, v := range service.Deployments {
+				if aws.StringValue(v.Id) != deploymentID {
+					continue
+				}
+
+				log.Printf("[INFO] ECS Service (%s) deployment (%s) %s: %d running, %d pending, %d failed of %d desired tasks", id, deploymentID,
+					aws.StringValue(v.RolloutState), aws.Int64Value(v.RunningCount), aws.Int64Value(v.PendingCount), aws.Int64Value(v.FailedTasks), aws.Int64Value(v.DesiredCount))
+
+				if aws.StringValue(v.RolloutState) == ecs.DeploymentRolloutStateFailed {
+					return service, status, fmt.Errorf("deployment (%s) failed: %s%s", deploymentID, aws.StringValue(v.RolloutStateReason), serviceEventsSummary(service.Events))
+				}
+			}
+
+			return service, status, nil
+		},
+		Timeout:    serviceStableTimeout,
+		MinTimeout: serviceStableMinTimeout,
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
+	outputRaw, err := stateConf.WaitForState()
+
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		if v := serviceEventsSummary(v.Events); v != "" {
+			tfresource.SetLastError(err, errors.New(strings.TrimSpace(v)))
+		}
+
+		return v, err
 	}
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
-		return err
+	return nil, err
+}
+
+// logServiceEvents logs, oldest first, the service events that are newer than the last one logged.
+// Without a last event, i.e. when waiting starts, nothing is logged.
+// It returns the ID of the newest event.
+func logServiceEvents(id string, events []*ecs.ServiceEvent, lastEventID string) string {
+	if len(events) == 0 {
+		return lastEventID
 	}
-	return nil
+
+	if lastEventID != "" {
+		// Events are returned newest first.
+		var n int
+		for n < len(events) && aws.StringValue(events[n].Id) != lastEventID {
+			n++
+		}
+
+		for i := n - 1; i >= 0; i-- {
+			log.Printf("[INFO] ECS Service (%s) event: %s", id, aws.StringValue(events[i].Message))
+		}
+	}
+
+	return aws.StringValue(events[0].Id)
+}
+
+// serviceEventsSummary returns the most recent service events, newest first, for inclusion in an error message.
+func serviceEventsSummary(events []*ecs.ServiceEvent) string {
+	var b strings.Builder
+
+	for i, v := range events {
+		if i == serviceEventsSummaryCount {
+			break
+		}
+
+		fmt.Fprintf(&b, "\n  %s: %s", aws.TimeValue(v.CreatedAt).Format(time.RFC3339), aws.StringValue(v.Message))
+	}
+
+	if b.Len() == 0 {
+		return ""
+	}
+
+	return "\nlatest service events:" + b.String()
 }
 
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
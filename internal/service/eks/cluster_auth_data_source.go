@@ -2,10 +2,24 @@ package eks
 
 import (
 	"fmt"
+	"log"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	// The maximum reported lifetime of a token, 1 minute before the presigned URL expires for some cushion.
+	execCredentialMaxDuration = presignedURLExpiration - 1*time.Minute
 )
 
 func DataSourceClusterAuth() *schema.Resource {
@@ -13,12 +27,42 @@ func DataSourceClusterAuth() *schema.Resource {
 		Read: dataSourceClusterAuthRead,
 
 		Schema: map[string]*schema.Schema{
+			"exec_credential": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"exec_credential_duration": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "14m",
+				ValidateFunc: validExecCredentialDuration,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
-
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"session_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"role_arn"},
+				ValidateFunc: validAssumeRoleSessionName,
+			},
 			"token": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -31,17 +75,75 @@ func DataSourceClusterAuth() *schema.Resource {
 func dataSourceClusterAuthRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).STSConn
 	name := d.Get("name").(string)
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		roleARN := v.(string)
+		creds := stscreds.NewCredentialsWithClient(conn, roleARN, func(p *stscreds.AssumeRoleProvider) {
+			if v, ok := d.GetOk("session_name"); ok {
+				p.RoleSessionName = v.(string)
+			}
+		})
+
+		// Sign with the assumed role's credentials, keeping the provider's STS endpoint and region.
+		conn = sts.New(meta.(*conns.AWSClient).Session.Copy(&conn.Config, &aws.Config{Credentials: creds}))
+	}
+
 	generator, err := NewGenerator(false, false)
 	if err != nil {
 		return fmt.Errorf("error getting token generator: %w", err)
 	}
+	signedAt := time.Now()
 	toke, err := generator.GetWithSTS(name, conn)
 	if err != nil {
 		return fmt.Errorf("error getting token: %w", err)
 	}
 
+	// Only the reported expiration changes, the cluster accepts the token for a fixed period after it is signed.
+	duration, _ := time.ParseDuration(d.Get("exec_credential_duration").(string))
+	toke.Expiration = signedAt.Add(duration)
+
 	d.SetId(name)
+	d.Set("exec_credential", generator.FormatJSON(toke))
+	d.Set("expiration", toke.Expiration.UTC().Format(time.RFC3339))
 	d.Set("token", toke.Token)
 
+	cluster, err := FindClusterByName(meta.(*conns.AWSClient).EKSConn, name)
+
+	if tfresource.NotFound(err) {
+		// A token can be generated for any cluster name, e.g. one that has the AWS IAM Authenticator server configured.
+		log.Printf("[WARN] EKS Cluster (%s) not found, kubeconfig not generated", name)
+		d.Set("kubeconfig", "")
+
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, eks.ErrCodeAccessDeniedException) {
+		// Generating a token only needs credentials, so callers without eks:DescribeCluster still get one.
+		log.Printf("[WARN] Not permitted to describe EKS Cluster (%s), kubeconfig not generated: %s", name, err)
+		d.Set("kubeconfig", "")
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EKS Cluster (%s): %w", name, err)
+	}
+
+	if aws.StringValue(cluster.Endpoint) == "" {
+		// The endpoint is not available until the cluster has been created.
+		log.Printf("[WARN] EKS Cluster (%s) endpoint not available, kubeconfig not generated", name)
+		d.Set("kubeconfig", "")
+
+		return nil
+	}
+
+	kubeconfig, err := clusterKubeconfig(cluster, toke.Token)
+
+	if err != nil {
+		return fmt.Errorf("error generating kubeconfig for EKS Cluster (%s): %w", name, err)
+	}
+
+	d.Set("kubeconfig", kubeconfig)
+
 	return nil
 }
@@ -1,10 +1,13 @@
 package eks_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/eks"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -24,7 +27,59 @@ func TestAccEKSClusterAuthDataSource_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceResourceName, "name", "foobar"),
 					resource.TestCheckResourceAttrSet(dataSourceResourceName, "token"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec_credential_duration", "14m"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "expiration", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`)),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "kubeconfig", ""),
 					testAccCheckClusterAuthToken(dataSourceResourceName),
+					testAccCheckClusterAuthExecCredential(dataSourceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterAuthDataSource_assumeRole(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_auth.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthDataSourceConfig_assumeRole(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "role_arn", roleResourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "session_name", rName),
+					resource.TestCheckResourceAttr(dataSourceResourceName, "exec_credential_duration", "5m"),
+					testAccCheckClusterAuthTokenIdentity(dataSourceResourceName, roleResourceName, rName),
+					testAccCheckClusterAuthExecCredential(dataSourceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterAuthDataSource_kubeconfig(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_eks_cluster_auth.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, eks.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterAuthDataSourceConfig_kubeconfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceResourceName, "name", resourceName, "name"),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`current-context: arn:[^:]+:eks:`)),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`server: https://`)),
+					resource.TestMatchResourceAttr(dataSourceResourceName, "kubeconfig", regexp.MustCompile(`token: k8s-aws-v1\.`)),
 				),
 			},
 		},
@@ -57,8 +112,112 @@ func testAccCheckClusterAuthToken(n string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckClusterAuthTokenIdentity(n, roleResourceName, sessionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		role, ok := s.RootModule().Resources[roleResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", roleResourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		verifier := tfeks.NewVerifier(name)
+		identity, err := verifier.Verify(rs.Primary.Attributes["token"])
+		if err != nil {
+			return fmt.Errorf("Error verifying token for cluster %q: %v", name, err)
+		}
+
+		if got, want := identity.CanonicalARN, role.Primary.Attributes["arn"]; got != want {
+			return fmt.Errorf("Unexpected token identity ARN: got %q, want %q", got, want)
+		}
+
+		if identity.SessionName != sessionName {
+			return fmt.Errorf("Unexpected token identity session name: got %q, want %q", identity.SessionName, sessionName)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterAuthExecCredential(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		var execCredential struct {
+			Kind   string `json:"kind"`
+			Status struct {
+				ExpirationTimestamp string `json:"expirationTimestamp"`
+				Token               string `json:"token"`
+			} `json:"status"`
+		}
+
+		if err := json.Unmarshal([]byte(rs.Primary.Attributes["exec_credential"]), &execCredential); err != nil {
+			return fmt.Errorf("Error unmarshalling exec_credential: %w", err)
+		}
+
+		if execCredential.Kind != "ExecCredential" {
+			return fmt.Errorf("Unexpected exec_credential kind: %q", execCredential.Kind)
+		}
+
+		if got, want := execCredential.Status.Token, rs.Primary.Attributes["token"]; got != want {
+			return fmt.Errorf("Unexpected exec_credential token: got %q, want %q", got, want)
+		}
+
+		if got, want := execCredential.Status.ExpirationTimestamp, rs.Primary.Attributes["expiration"]; got != want {
+			return fmt.Errorf("Unexpected exec_credential expirationTimestamp: got %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 const testAccCheckAWSEksClusterAuthConfig_basic = `
 data "aws_eks_cluster_auth" "test" {
   name = "foobar"
 }
 `
+
+func testAccClusterAuthDataSourceConfig_assumeRole(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+data "aws_eks_cluster_auth" "test" {
+  name         = "foobar"
+  role_arn                 = aws_iam_role.test.arn
+  session_name             = %[1]q
+  exec_credential_duration = "5m"
+}
+`, rName)
+}
+
+func testAccClusterAuthDataSourceConfig_kubeconfig(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_Required(rName), `
+data "aws_eks_cluster_auth" "test" {
+  name = aws_eks_cluster.test.name
+}
+`)
+}
@@ -0,0 +1,78 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"gopkg.in/yaml.v2"
+)
+
+// kubeconfig is the subset of the Kubernetes client configuration file format
+// needed to connect to a single cluster with a bearer token.
+type kubeconfig struct {
+	APIVersion     string              `yaml:"apiVersion"`
+	Kind           string              `yaml:"kind"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	CurrentContext string              `yaml:"current-context"`
+	Users          []kubeconfigUser    `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Token string `yaml:"token"`
+	} `yaml:"user"`
+}
+
+// clusterKubeconfig returns a kubeconfig document for the specified cluster that authenticates with the specified token.
+// As with `aws eks update-kubeconfig`, the cluster ARN is used to name the cluster, context and user entries.
+func clusterKubeconfig(cluster *eks.Cluster, token string) (string, error) {
+	var caData string
+	if cluster.CertificateAuthority != nil {
+		caData = aws.StringValue(cluster.CertificateAuthority.Data)
+	}
+
+	name := aws.StringValue(cluster.Arn)
+
+	c := kubeconfigCluster{Name: name}
+	c.Cluster.Server = aws.StringValue(cluster.Endpoint)
+	c.Cluster.CertificateAuthorityData = caData
+
+	ctx := kubeconfigContext{Name: name}
+	ctx.Context.Cluster = name
+	ctx.Context.User = name
+
+	u := kubeconfigUser{Name: name}
+	u.User.Token = token
+
+	b, err := yaml.Marshal(&kubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []kubeconfigCluster{c},
+		Contexts:       []kubeconfigContext{ctx},
+		CurrentContext: name,
+		Users:          []kubeconfigUser{u},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,60 @@
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"gopkg.in/yaml.v2"
+)
+
+func TestClusterKubeconfig(t *testing.T) {
+	arn := "arn:aws:eks:us-west-2:123456789012:cluster/example" //lintignore:AWSAT003,AWSAT005
+	cluster := &eks.Cluster{
+		Arn:      aws.String(arn),
+		Endpoint: aws.String("https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com"), //lintignore:AWSAT003
+		CertificateAuthority: &eks.Certificate{
+			Data: aws.String("Y2VydGlmaWNhdGU="),
+		},
+		Name: aws.String("example"),
+	}
+
+	output, err := clusterKubeconfig(cluster, "k8s-aws-v1.token")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got kubeconfig
+	if err := yaml.Unmarshal([]byte(output), &got); err != nil {
+		t.Fatalf("error unmarshalling kubeconfig: %s", err)
+	}
+
+	if got.APIVersion != "v1" || got.Kind != "Config" {
+		t.Errorf("unexpected apiVersion/kind: %s/%s", got.APIVersion, got.Kind)
+	}
+
+	if got.CurrentContext != arn {
+		t.Errorf("expected current-context to be %q, got %q", arn, got.CurrentContext)
+	}
+
+	if len(got.Clusters) != 1 || len(got.Contexts) != 1 || len(got.Users) != 1 {
+		t.Fatalf("expected exactly 1 cluster, context and user, got %d, %d, %d", len(got.Clusters), len(got.Contexts), len(got.Users))
+	}
+
+	if v := got.Clusters[0].Cluster.Server; v != aws.StringValue(cluster.Endpoint) {
+		t.Errorf("expected server to be %q, got %q", aws.StringValue(cluster.Endpoint), v)
+	}
+
+	if v := got.Clusters[0].Cluster.CertificateAuthorityData; v != "Y2VydGlmaWNhdGU=" {
+		t.Errorf("expected certificate-authority-data to be %q, got %q", "Y2VydGlmaWNhdGU=", v)
+	}
+
+	if v := got.Contexts[0].Context; v.Cluster != arn || v.User != arn {
+		t.Errorf("expected context to reference cluster and user %q, got %q and %q", arn, v.Cluster, v.User)
+	}
+
+	if v := got.Users[0].User.Token; v != "k8s-aws-v1.token" {
+		t.Errorf("expected token to be %q, got %q", "k8s-aws-v1.token", v)
+	}
+}
@@ -4,13 +4,14 @@ https://github.com/kubernetes-sigs/aws-iam-authenticator/blob/7547c74e660f8d34d9
 
 With the following modifications:
 
- - Removal of all Generator interface methods and implementations except GetWithSTS
+ - Removal of all Generator interface methods and implementations except GetWithSTS and FormatJSON
  - Removal of other unused code
  - Use *sts.STS instead of stsiface.STSAPI in Generator interface and GetWithSTS implementation
  - Hard copy and use local Canonicalize implementation instead of "sigs.k8s.io/aws-iam-authenticator/pkg/arn"
  - Fix staticcheck reports
  - Ignore errorlint reports
  - Refactor deprecated io/ioutil in Go 1.16
+ - Use local ExecCredential types in FormatJSON instead of "k8s.io/client-go/pkg/apis/clientauthentication/v1beta1"
 */
 
 /*
@@ -94,6 +95,9 @@ const (
 	// https://golang.org/pkg/time/#pkg-constants
 	dateHeaderFormat = "20060102T150405Z"
 	hostRegexp       = `^sts(\.[a-z1-9\-]+)?\.amazonaws\.com(\.cn)?$`
+	// The ExecCredential API version and kind returned by FormatJSON
+	execCredentialAPIVersion = "client.authentication.k8s.io/v1beta1"
+	execCredentialKind       = "ExecCredential"
 )
 
 // Token is generated and used by Kubernetes client-go to authenticate with a Kubernetes cluster.
@@ -154,10 +158,26 @@ type getCallerIdentityWrapper struct {
 	} `json:"GetCallerIdentityResponse"`
 }
 
+// execCredential is the subset of the Kubernetes client authentication ExecCredential
+// object that is returned to client-go credential plugins.
+type execCredential struct {
+	Kind       string                `json:"kind"`
+	APIVersion string                `json:"apiVersion"`
+	Spec       struct{}              `json:"spec"`
+	Status     *execCredentialStatus `json:"status"`
+}
+
+type execCredentialStatus struct {
+	ExpirationTimestamp string `json:"expirationTimestamp"`
+	Token               string `json:"token"`
+}
+
 // Generator provides new tokens for the AWS IAM Authenticator.
 type Generator interface {
 	// GetWithSTS returns a token valid for clusterID using the given STS client.
 	GetWithSTS(clusterID string, stsAPI *sts.STS) (Token, error)
+	// FormatJSON returns the client auth formatted json for the ExecCredential auth
+	FormatJSON(Token) string
 }
 
 type generator struct {
@@ -196,6 +216,20 @@ func (g generator) GetWithSTS(clusterID string, stsAPI *sts.STS) (Token, error)
 	return Token{v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString)), tokenExpiration}, nil
 }
 
+// FormatJSON formats the json to support ExecCredential authentication
+func (g generator) FormatJSON(token Token) string {
+	execInput := &execCredential{
+		Kind:       execCredentialKind,
+		APIVersion: execCredentialAPIVersion,
+		Status: &execCredentialStatus{
+			ExpirationTimestamp: token.Expiration.UTC().Format(time.RFC3339),
+			Token:               token.Token,
+		},
+	}
+	enc, _ := json.Marshal(execInput)
+	return string(enc)
+}
+
 // Verifier validates tokens by calling STS and returning the associated identity.
 type Verifier interface {
 	Verify(token string) (*Identity, error)
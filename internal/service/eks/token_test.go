@@ -7,6 +7,7 @@ With the following modifications:
  - Fix staticcheck reports
  - Ignore errorlint reports
  - Refactor deprecated io/ioutil in Go 1.16
+ - Replace TestFormatJson with TestFormatJSON for local ExecCredential types
 */
 
 package eks
@@ -274,3 +275,42 @@ func TestVerifyCanonicalARN(t *testing.T) {
 		t.Errorf("expected CannonicalARN to be %q but was %q", canonicalARN, identity.CanonicalARN)
 	}
 }
+
+func TestFormatJSON(t *testing.T) {
+	expiration := time.Date(2022, time.May, 1, 10, 30, 0, 0, time.FixedZone("UTC-7", -7*60*60))
+	token := Token{Token: validToken, Expiration: expiration}
+
+	g, err := NewGenerator(false, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var execCredential struct {
+		Kind       string                 `json:"kind"`
+		APIVersion string                 `json:"apiVersion"`
+		Spec       map[string]interface{} `json:"spec"`
+		Status     struct {
+			ExpirationTimestamp string `json:"expirationTimestamp"`
+			Token               string `json:"token"`
+		} `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(g.FormatJSON(token)), &execCredential); err != nil {
+		t.Fatalf("unexpected error unmarshalling ExecCredential: %v", err)
+	}
+
+	if execCredential.Kind != "ExecCredential" {
+		t.Errorf("expected kind to be %q but was %q", "ExecCredential", execCredential.Kind)
+	}
+	if execCredential.APIVersion != "client.authentication.k8s.io/v1beta1" {
+		t.Errorf("expected apiVersion to be %q but was %q", "client.authentication.k8s.io/v1beta1", execCredential.APIVersion)
+	}
+	if execCredential.Spec == nil {
+		t.Errorf("expected spec to be present")
+	}
+	if execCredential.Status.Token != validToken {
+		t.Errorf("expected token to be %q but was %q", validToken, execCredential.Status.Token)
+	}
+	if expected := "2022-05-01T17:30:00Z"; execCredential.Status.ExpirationTimestamp != expected {
+		t.Errorf("expected expirationTimestamp to be %q but was %q", expected, execCredential.Status.ExpirationTimestamp)
+	}
+}
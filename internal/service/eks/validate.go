@@ -3,6 +3,9 @@ package eks
 import (
 	"fmt"
 	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -22,3 +25,25 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+var validAssumeRoleSessionName = validation.All(
+	validation.StringLenBetween(2, 64),
+	validation.StringMatch(regexp.MustCompile(`^[\w+=,.@\-]*$`), "must only contain alphanumeric characters, underscores and any of +=,.@-"),
+)
+
+// validExecCredentialDuration validates a string can be parsed as a valid time.Duration
+// and is within a minimum of 1 minute and the maximum reported lifetime of a cluster authentication token
+func validExecCredentialDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < 1*time.Minute || duration > execCredentialMaxDuration {
+		errors = append(errors, fmt.Errorf("%q must be between 1 minute (1m) and %s, inclusive", k, execCredentialMaxDuration))
+	}
+
+	return
+}
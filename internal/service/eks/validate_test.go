@@ -57,3 +57,43 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidExecCredentialDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "1m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "14m",
+			ErrCount: 0,
+		},
+		{
+			Value:    "59s",
+			ErrCount: 1,
+		},
+		{
+			Value:    "15m",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-5m",
+			ErrCount: 1,
+		},
+		{
+			Value:    "invalid",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validExecCredentialDuration(tc.Value, "exec_credential_duration")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected the EKS Cluster Auth exec credential duration to trigger a validation error: %s, expected %d, got %d errors", tc.Value, tc.ErrCount, len(errors))
+		}
+	}
+}
@@ -17,6 +17,9 @@ func TestAccFMS_serial(t *testing.T) {
 			"resourceTags":           testAccPolicy_resourceTags,
 			"tags":                   testAccPolicy_tags,
 		},
+		"ScopedAdminAccount": {
+			"basic": testAccScopedAdminAccount_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -0,0 +1,334 @@
+package fms
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceScopedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceScopedAdminAccountCreate,
+		Read:   resourceScopedAdminAccountRead,
+		Update: resourceScopedAdminAccountUpdate,
+		Delete: resourceScopedAdminAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"admin_scope": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"accounts": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidAccountID,
+										},
+									},
+									"all_accounts_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_specified_accounts": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"organizational_unit_scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_organizational_units_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_specified_organizational_units": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"organizational_units": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"policy_type_scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_policy_types_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"policy_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(fms.SecurityServiceType_Values(), false),
+										},
+									},
+								},
+							},
+						},
+						"region_scope": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"all_regions_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"regions": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: verify.ValidRegionName,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceScopedAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	accountID := d.Get("account_id").(string)
+
+	if err := putScopedAdminAccount(conn, accountID, expandAdminScope(d.Get("admin_scope").([]interface{}))); err != nil {
+		return fmt.Errorf("error creating FMS Scoped Admin Account (%s): %w", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	return resourceScopedAdminAccountRead(d, meta)
+}
+
+func resourceScopedAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	output, err := FindAdminScopeByAccountID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] FMS Scoped Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Scoped Admin Account (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(output.Status); status == fms.OrganizationStatusOffboarding || status == fms.OrganizationStatusOffboardingComplete {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading FMS Scoped Admin Account (%s): %s after creation", d.Id(), status)
+		}
+
+		log.Printf("[WARN] FMS Scoped Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", d.Id())
+
+	if err := d.Set("admin_scope", flattenAdminScope(output.AdminScope)); err != nil {
+		return fmt.Errorf("error setting admin_scope: %w", err)
+	}
+
+	return nil
+}
+
+func resourceScopedAdminAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	if d.HasChange("admin_scope") {
+		if err := putScopedAdminAccount(conn, d.Id(), expandAdminScope(d.Get("admin_scope").([]interface{}))); err != nil {
+			return fmt.Errorf("error updating FMS Scoped Admin Account (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceScopedAdminAccountRead(d, meta)
+}
+
+func resourceScopedAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).FMSConn
+
+	// DisassociateAdminAccount only disassociates the calling account, so the management account
+	// removes the administrator by deregistering it as Firewall Manager's delegated administrator.
+	input := &organizations.DeregisterDelegatedAdministratorInput{
+		AccountId:        aws.String(d.Id()),
+		ServicePrincipal: aws.String(meta.(*conns.AWSClient).PartitionHostname(fms.EndpointsID)),
+	}
+
+	log.Printf("[DEBUG] Deleting FMS Scoped Admin Account: %s", d.Id())
+	_, err := meta.(*conns.AWSClient).OrganizationsConn.DeregisterDelegatedAdministrator(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotRegisteredException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting FMS Scoped Admin Account (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitAdminScopeOffboarded(conn, d.Id()); err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("error waiting for FMS Scoped Admin Account (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func putScopedAdminAccount(conn *fms.FMS, accountID string, scope *fms.AdminScope) error {
+	input := &fms.PutAdminAccountInput{
+		AdminAccount: aws.String(accountID),
+		AdminScope:   scope,
+	}
+
+	log.Printf("[DEBUG] Putting FMS Scoped Admin Account: %s", input)
+	if _, err := conn.PutAdminAccount(input); err != nil {
+		return err
+	}
+
+	if _, err := waitAdminScopeOnboarded(conn, accountID); err != nil {
+		return fmt.Errorf("waiting for scope onboarding: %w", err)
+	}
+
+	return nil
+}
+
+func expandAdminScope(tfList []interface{}) *fms.AdminScope {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &fms.AdminScope{}
+
+	if v, ok := tfMap["account_scope"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.AccountScope = &fms.AccountScope{
+			Accounts:                 flex.ExpandStringSet(m["accounts"].(*schema.Set)),
+			AllAccountsEnabled:       aws.Bool(m["all_accounts_enabled"].(bool)),
+			ExcludeSpecifiedAccounts: aws.Bool(m["exclude_specified_accounts"].(bool)),
+		}
+	}
+
+	if v, ok := tfMap["organizational_unit_scope"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.OrganizationalUnitScope = &fms.OrganizationalUnitScope{
+			AllOrganizationalUnitsEnabled:       aws.Bool(m["all_organizational_units_enabled"].(bool)),
+			ExcludeSpecifiedOrganizationalUnits: aws.Bool(m["exclude_specified_organizational_units"].(bool)),
+			OrganizationalUnits:                 flex.ExpandStringSet(m["organizational_units"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := tfMap["policy_type_scope"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.PolicyTypeScope = &fms.PolicyTypeScope{
+			AllPolicyTypesEnabled: aws.Bool(m["all_policy_types_enabled"].(bool)),
+			PolicyTypes:           flex.ExpandStringSet(m["policy_types"].(*schema.Set)),
+		}
+	}
+
+	if v, ok := tfMap["region_scope"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		apiObject.RegionScope = &fms.RegionScope{
+			AllRegionsEnabled: aws.Bool(m["all_regions_enabled"].(bool)),
+			Regions:           flex.ExpandStringSet(m["regions"].(*schema.Set)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAdminScope(apiObject *fms.AdminScope) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AccountScope; v != nil {
+		tfMap["account_scope"] = []interface{}{map[string]interface{}{
+			"accounts":                   flex.FlattenStringSet(v.Accounts),
+			"all_accounts_enabled":       aws.BoolValue(v.AllAccountsEnabled),
+			"exclude_specified_accounts": aws.BoolValue(v.ExcludeSpecifiedAccounts),
+		}}
+	}
+
+	if v := apiObject.OrganizationalUnitScope; v != nil {
+		tfMap["organizational_unit_scope"] = []interface{}{map[string]interface{}{
+			"all_organizational_units_enabled":       aws.BoolValue(v.AllOrganizationalUnitsEnabled),
+			"exclude_specified_organizational_units": aws.BoolValue(v.ExcludeSpecifiedOrganizationalUnits),
+			"organizational_units":                   flex.FlattenStringSet(v.OrganizationalUnits),
+		}}
+	}
+
+	if v := apiObject.PolicyTypeScope; v != nil {
+		tfMap["policy_type_scope"] = []interface{}{map[string]interface{}{
+			"all_policy_types_enabled": aws.BoolValue(v.AllPolicyTypesEnabled),
+			"policy_types":             flex.FlattenStringSet(v.PolicyTypes),
+		}}
+	}
+
+	if v := apiObject.RegionScope; v != nil {
+		tfMap["region_scope"] = []interface{}{map[string]interface{}{
+			"all_regions_enabled": aws.BoolValue(v.AllRegionsEnabled),
+			"regions":             flex.FlattenStringSet(v.Regions),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
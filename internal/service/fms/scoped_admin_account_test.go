@@ -0,0 +1,134 @@
+package fms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tffms "github.com/hashicorp/terraform-provider-aws/internal/service/fms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccScopedAdminAccount_basic(t *testing.T) {
+	var providers []*schema.Provider
+	resourceName := "aws_fms_scoped_admin_account.test"
+	dataSourceIdentity := "data.aws_caller_identity.admin"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckFmsAdmin(t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, fms.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckFmsScopedAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFmsScopedAdminAccountConfig_policyTypes(fms.SecurityServiceTypeWafv2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmsScopedAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", dataSourceIdentity, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "admin_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_scope.0.policy_type_scope.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_scope.0.policy_type_scope.0.all_policy_types_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "admin_scope.0.policy_type_scope.0.policy_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "admin_scope.0.policy_type_scope.0.policy_types.*", fms.SecurityServiceTypeWafv2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFmsScopedAdminAccountConfig_policyTypes(fms.SecurityServiceTypeShieldAdvanced),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFmsScopedAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "admin_scope.0.policy_type_scope.0.policy_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "admin_scope.0.policy_type_scope.0.policy_types.*", fms.SecurityServiceTypeShieldAdvanced),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFmsScopedAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProviderFmsAdmin.Meta().(*conns.AWSClient).FMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_scoped_admin_account" {
+			continue
+		}
+
+		output, err := tffms.FindAdminScopeByAccountID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.Status) == fms.OrganizationStatusOffboardingComplete {
+			continue
+		}
+
+		return fmt.Errorf("FMS Scoped Admin Account %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckFmsScopedAdminAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Scoped Admin Account ID is set")
+		}
+
+		conn := testAccProviderFmsAdmin.Meta().(*conns.AWSClient).FMSConn
+
+		_, err := tffms.FindAdminScopeByAccountID(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccFmsScopedAdminAccountConfig_policyTypes(policyType string) string {
+	return acctest.ConfigCompose(
+		testAccFmsAdminRegionProviderConfig(),
+		acctest.ConfigAlternateAccountProvider(),
+		fmt.Sprintf(`
+data "aws_caller_identity" "admin" {
+  provider = "awsalternate"
+}
+
+resource "aws_fms_admin_account" "test" {}
+
+resource "aws_fms_scoped_admin_account" "test" {
+  account_id = data.aws_caller_identity.admin.account_id
+
+  admin_scope {
+    policy_type_scope {
+      all_policy_types_enabled = false
+      policy_types             = [%[1]q]
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, policyType))
+}
@@ -0,0 +1,24 @@
+package fms
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusAdminScope(conn *fms.FMS, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindAdminScopeByAccountID(conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,51 @@
+package fms
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	// Maximum amount of time to wait for an administrator's scope to finish onboarding
+	adminScopeOnboardedTimeout = 10 * time.Minute
+
+	// Maximum amount of time to wait for an administrator's scope to finish offboarding
+	adminScopeOffboardedTimeout = 10 * time.Minute
+)
+
+func waitAdminScopeOnboarded(conn *fms.FMS, accountID string) (*fms.GetAdminScopeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{fms.OrganizationStatusOnboarding},
+		Target:                    []string{fms.OrganizationStatusOnboardingComplete},
+		Refresh:                   statusAdminScope(conn, accountID),
+		Timeout:                   adminScopeOnboardedTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*fms.GetAdminScopeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAdminScopeOffboarded(conn *fms.FMS, accountID string) (*fms.GetAdminScopeOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{fms.OrganizationStatusOnboardingComplete, fms.OrganizationStatusOffboarding},
+		Target:  []string{fms.OrganizationStatusOffboardingComplete},
+		Refresh: statusAdminScope(conn, accountID),
+		Timeout: adminScopeOffboardedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*fms.GetAdminScopeOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -69,6 +70,45 @@ func ResourceDetector() *schema.Resource {
 				Default:  true,
 			},
 
+			"feature": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"datasources"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+									},
+									"status": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+									},
+								},
+							},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+
 			// finding_publishing_frequency is marked as Computed:true since
 			// GuardDuty member accounts inherit setting from master account
 			"finding_publishing_frequency": {
@@ -102,6 +142,10 @@ func resourceDetectorCreate(d *schema.ResourceData, meta interface{}) error {
 		input.DataSources = expandGuardDutyDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("feature"); ok && v.(*schema.Set).Len() > 0 {
+		input.Features = expandDetectorFeatureConfigurations(v.(*schema.Set).List())
+	}
+
 	if len(tags) > 0 {
 		input.Tags = Tags(tags.IgnoreAWS())
 	}
@@ -156,6 +200,11 @@ func resourceDetectorRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("enable", aws.StringValue(gdo.Status) == guardduty.DetectorStatusEnabled)
+
+	if err := d.Set("feature", flattenDetectorFeatureConfigurationResults(filterDetectorFeatureConfigurationResults(gdo.Features, d.Get("feature").(*schema.Set).List()))); err != nil {
+		return fmt.Errorf("error setting feature: %w", err)
+	}
+
 	d.Set("finding_publishing_frequency", gdo.FindingPublishingFrequency)
 
 	tags := KeyValueTags(gdo.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
@@ -186,6 +235,10 @@ func resourceDetectorUpdate(d *schema.ResourceData, meta interface{}) error {
 			input.DataSources = expandGuardDutyDataSourceConfigurations(d.Get("datasources").([]interface{})[0].(map[string]interface{}))
 		}
 
+		if d.HasChange("feature") {
+			input.Features = expandDetectorFeatureConfigurations(d.Get("feature").(*schema.Set).List())
+		}
+
 		log.Printf("[DEBUG] Update GuardDuty Detector: %s", input)
 		_, err := conn.UpdateDetector(&input)
 		if err != nil {
@@ -291,3 +344,179 @@ func flattenGuardDutyS3LogsConfigurationResult(apiObject *guardduty.S3LogsConfig
 
 	return tfMap
 }
+
+func expandDetectorFeatureConfigurations(tfList []interface{}) []*guardduty.DetectorFeatureConfiguration {
+	var apiObjects []*guardduty.DetectorFeatureConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandDetectorFeatureConfiguration(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandDetectorFeatureConfiguration(tfMap map[string]interface{}) *guardduty.DetectorFeatureConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &guardduty.DetectorFeatureConfiguration{}
+
+	if v, ok := tfMap["additional_configuration"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AdditionalConfiguration = expandDetectorAdditionalConfigurations(v.List())
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.Name = aws.String(v)
+	}
+
+	if v, ok := tfMap["status"].(string); ok && v != "" {
+		apiObject.Status = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandDetectorAdditionalConfigurations(tfList []interface{}) []*guardduty.DetectorAdditionalConfiguration {
+	var apiObjects []*guardduty.DetectorAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.DetectorAdditionalConfiguration{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["status"].(string); ok && v != "" {
+			apiObject.Status = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// filterDetectorFeatureConfigurationResults returns the features, and their additional configuration,
+// that are named in the configured features. GuardDuty returns every feature of a detector, so all are
+// returned if no features are configured.
+func filterDetectorFeatureConfigurationResults(apiObjects []*guardduty.DetectorFeatureConfigurationResult, tfList []interface{}) []*guardduty.DetectorFeatureConfigurationResult {
+	if len(tfList) == 0 {
+		return apiObjects
+	}
+
+	configured := featureAdditionalConfigurationNames(tfList)
+	var result []*guardduty.DetectorFeatureConfigurationResult
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		additionalConfigurationNames, ok := configured[aws.StringValue(apiObject.Name)]
+
+		if !ok {
+			continue
+		}
+
+		feature := &guardduty.DetectorFeatureConfigurationResult{
+			Name:   apiObject.Name,
+			Status: apiObject.Status,
+		}
+
+		for _, v := range apiObject.AdditionalConfiguration {
+			if v != nil && additionalConfigurationNames[aws.StringValue(v.Name)] {
+				feature.AdditionalConfiguration = append(feature.AdditionalConfiguration, v)
+			}
+		}
+
+		result = append(result, feature)
+	}
+
+	return result
+}
+
+// featureAdditionalConfigurationNames returns the names of the configured features, mapped to the names of their
+// configured additional configuration.
+func featureAdditionalConfigurationNames(tfList []interface{}) map[string]map[string]bool {
+	names := make(map[string]map[string]bool)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		additionalConfigurationNames := make(map[string]bool)
+
+		if v, ok := tfMap["additional_configuration"].(*schema.Set); ok {
+			for _, tfMapRaw := range v.List() {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					additionalConfigurationNames[tfMap["name"].(string)] = true
+				}
+			}
+		}
+
+		names[tfMap["name"].(string)] = additionalConfigurationNames
+	}
+
+	return names
+}
+
+func flattenDetectorFeatureConfigurationResults(apiObjects []*guardduty.DetectorFeatureConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenDetectorFeatureConfigurationResult(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenDetectorFeatureConfigurationResult(apiObject *guardduty.DetectorFeatureConfigurationResult) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"additional_configuration": flattenDetectorAdditionalConfigurationResults(apiObject.AdditionalConfiguration),
+		"name":                     aws.StringValue(apiObject.Name),
+		"status":                   aws.StringValue(apiObject.Status),
+	}
+
+	return tfMap
+}
+
+func flattenDetectorAdditionalConfigurationResults(apiObjects []*guardduty.DetectorAdditionalConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":   aws.StringValue(apiObject.Name),
+			"status": aws.StringValue(apiObject.Status),
+		})
+	}
+
+	return tfList
+}
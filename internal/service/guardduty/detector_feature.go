@@ -0,0 +1,154 @@
+package guardduty
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceDetectorFeature() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDetectorFeaturePut,
+		Read:   resourceDetectorFeatureRead,
+		Update: resourceDetectorFeaturePut,
+		Delete: schema.Noop,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"additional_configuration": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureAdditionalConfiguration_Values(), false),
+						},
+						"status": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+						},
+					},
+				},
+			},
+
+			"detector_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.DetectorFeature_Values(), false),
+			},
+
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(guardduty.FeatureStatus_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDetectorFeaturePut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name := d.Get("detector_id").(string), d.Get("name").(string)
+
+	input := &guardduty.UpdateDetectorInput{
+		DetectorId: aws.String(detectorID),
+		Features: []*guardduty.DetectorFeatureConfiguration{
+			expandDetectorFeatureConfiguration(map[string]interface{}{
+				"additional_configuration": d.Get("additional_configuration"),
+				"name":                     name,
+				"status":                   d.Get("status"),
+			}),
+		},
+	}
+
+	_, err := conn.UpdateDetector(input)
+
+	if err != nil {
+		return fmt.Errorf("error updating GuardDuty Detector (%s) Feature (%s): %w", detectorID, name, err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(detectorFeatureCreateID(detectorID, name))
+	}
+
+	return resourceDetectorFeatureRead(d, meta)
+}
+
+func resourceDetectorFeatureRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GuardDutyConn
+
+	detectorID, name, err := DetectorFeatureParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	feature, err := FindDetectorFeatureByTwoPartKey(conn, detectorID, name)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GuardDuty Detector Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GuardDuty Detector Feature (%s): %w", d.Id(), err)
+	}
+
+	// Only the additional configuration that is configured is read, unless the resource is being imported.
+	// An imported resource has only its ID set.
+	if d.Get("detector_id").(string) != "" {
+		features := filterDetectorFeatureConfigurationResults([]*guardduty.DetectorFeatureConfigurationResult{feature}, []interface{}{map[string]interface{}{
+			"additional_configuration": d.Get("additional_configuration").(*schema.Set),
+			"name":                     name,
+		}})
+		feature = features[0]
+	}
+
+	if err := d.Set("additional_configuration", flattenDetectorAdditionalConfigurationResults(feature.AdditionalConfiguration)); err != nil {
+		return fmt.Errorf("error setting additional_configuration: %w", err)
+	}
+	d.Set("detector_id", detectorID)
+	d.Set("name", feature.Name)
+	d.Set("status", feature.Status)
+
+	return nil
+}
+
+const detectorFeatureIDSeparator = "/"
+
+func detectorFeatureCreateID(detectorID, name string) string {
+	return detectorID + detectorFeatureIDSeparator + name
+}
+
+func DetectorFeatureParseID(id string) (string, string, error) {
+	parts := strings.Split(id, detectorFeatureIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected DETECTOR_ID%[2]sFEATURE_NAME", id, detectorFeatureIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,163 @@
+package guardduty_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfguardduty "github.com/hashicorp/terraform-provider-aws/internal/service/guardduty"
+)
+
+func testAccDetectorFeature_basic(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:  acctest.Providers,
+		// GuardDuty Detector Features cannot be deleted separately.
+		// Ensure parent resource is destroyed instead.
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyDetectorFeatureConfig_basic(guardduty.DetectorFeatureRdsLoginEvents, guardduty.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", "aws_guardduty_detector.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", guardduty.DetectorFeatureRdsLoginEvents),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.FeatureStatusEnabled),
+				),
+			},
+			{
+				Config: testAccGuardDutyDetectorFeatureConfig_basic(guardduty.DetectorFeatureRdsLoginEvents, guardduty.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.FeatureStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_additionalConfiguration(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyDetectorFeatureConfig_additionalConfiguration(guardduty.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.name", guardduty.FeatureAdditionalConfigurationEksAddonManagement),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", guardduty.FeatureStatusDisabled),
+					resource.TestCheckResourceAttr(resourceName, "name", guardduty.DetectorFeatureEksRuntimeMonitoring),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.FeatureStatusEnabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyDetectorFeatureConfig_additionalConfiguration(guardduty.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.0.status", guardduty.FeatureStatusEnabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccDetectorFeature_eksRuntimeMonitoring(t *testing.T) {
+	resourceName := "aws_guardduty_detector_feature.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyDetectorFeatureConfig_basic(guardduty.DetectorFeatureEksRuntimeMonitoring, guardduty.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorFeatureExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "additional_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "name", guardduty.DetectorFeatureEksRuntimeMonitoring),
+					resource.TestCheckResourceAttr(resourceName, "status", guardduty.FeatureStatusEnabled),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"additional_configuration"},
+			},
+		},
+	})
+}
+
+func testAccCheckDetectorFeatureExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GuardDuty Detector Feature ID is set")
+		}
+
+		detectorID, name, err := tfguardduty.DetectorFeatureParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
+
+		_, err = tfguardduty.FindDetectorFeatureByTwoPartKey(conn, detectorID, name)
+
+		return err
+	}
+}
+
+func testAccGuardDutyDetectorFeatureConfig_basic(name, status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = %[1]q
+  status      = %[2]q
+}
+`, name, status)
+}
+
+func testAccGuardDutyDetectorFeatureConfig_additionalConfiguration(status string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_detector_feature" "test" {
+  detector_id = aws_guardduty_detector.test.id
+  name        = "EKS_RUNTIME_MONITORING"
+  status      = "ENABLED"
+
+  additional_configuration {
+    name   = "EKS_ADDON_MANAGEMENT"
+    status = %[1]q
+  }
+}
+`, status)
+}
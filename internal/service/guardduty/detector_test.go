@@ -143,6 +143,52 @@ func testAccDetector_datasources_s3logs(t *testing.T) {
 	})
 }
 
+func testAccDetector_features(t *testing.T) {
+	resourceName := "aws_guardduty_detector.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyDetectorConfigFeatures(guardduty.FeatureStatusEnabled, guardduty.FeatureStatusDisabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":   guardduty.DetectorFeatureEbsMalwareProtection,
+						"status": guardduty.FeatureStatusEnabled,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"additional_configuration.#":        "1",
+						"additional_configuration.0.name":   guardduty.FeatureAdditionalConfigurationEksAddonManagement,
+						"additional_configuration.0.status": guardduty.FeatureStatusDisabled,
+						"name":                              guardduty.DetectorFeatureEksRuntimeMonitoring,
+						"status":                            guardduty.FeatureStatusEnabled,
+					}),
+				),
+			},
+			{
+				Config: testAccGuardDutyDetectorConfigFeatures(guardduty.FeatureStatusDisabled, guardduty.FeatureStatusEnabled),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDetectorExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"name":   guardduty.DetectorFeatureEbsMalwareProtection,
+						"status": guardduty.FeatureStatusDisabled,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"additional_configuration.0.status": guardduty.FeatureStatusEnabled,
+						"name":                              guardduty.DetectorFeatureEksRuntimeMonitoring,
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDetectorDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).GuardDutyConn
 
@@ -234,3 +280,24 @@ resource "aws_guardduty_detector" "test" {
 }
 `, enable)
 }
+
+func testAccGuardDutyDetectorConfigFeatures(malwareProtectionStatus, addonManagementStatus string) string {
+	return fmt.Sprintf(`
+resource "aws_guardduty_detector" "test" {
+  feature {
+    name   = "EBS_MALWARE_PROTECTION"
+    status = %[1]q
+  }
+
+  feature {
+    name   = "EKS_RUNTIME_MONITORING"
+    status = "ENABLED"
+
+    additional_configuration {
+      name   = "EKS_ADDON_MANAGEMENT"
+      status = %[2]q
+    }
+  }
+}
+`, malwareProtectionStatus, addonManagementStatus)
+}
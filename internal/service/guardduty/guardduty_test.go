@@ -10,10 +10,16 @@ func TestAccGuardDuty_serial(t *testing.T) {
 		"Detector": {
 			"basic":              testAccDetector_basic,
 			"datasources_s3logs": testAccDetector_datasources_s3logs,
+			"features":           testAccDetector_features,
 			"tags":               testAccDetector_tags,
 			"datasource_basic":   testAccDetectorDataSource_basic,
 			"datasource_id":      testAccDetectorDataSource_ID,
 		},
+		"DetectorFeature": {
+			"basic":                   testAccDetectorFeature_basic,
+			"additionalConfiguration": testAccDetectorFeature_additionalConfiguration,
+			"eksRuntimeMonitoring":    testAccDetectorFeature_eksRuntimeMonitoring,
+		},
 		"Filter": {
 			"basic":      testAccFilter_basic,
 			"update":     testAccFilter_update,
@@ -31,8 +37,10 @@ func TestAccGuardDuty_serial(t *testing.T) {
 			"basic": testAccOrganizationAdminAccount_basic,
 		},
 		"OrganizationConfiguration": {
-			"basic":  testAccOrganizationConfiguration_basic,
-			"s3Logs": testAccOrganizationConfiguration_s3logs,
+			"basic":                         testAccOrganizationConfiguration_basic,
+			"autoEnableOrganizationMembers": testAccOrganizationConfiguration_autoEnableOrganizationMembers,
+			"features":                      testAccOrganizationConfiguration_features,
+			"s3Logs":                        testAccOrganizationConfiguration_s3logs,
 		},
 		"ThreatIntelSet": {
 			"basic": testAccThreatintelset_basic,
@@ -25,8 +25,19 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"auto_enable": {
-				Type:     schema.TypeBool,
-				Required: true,
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use auto_enable_organization_members instead",
+				ExactlyOneOf: []string{"auto_enable", "auto_enable_organization_members"},
+			},
+
+			"auto_enable_organization_members": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"auto_enable", "auto_enable_organization_members"},
+				ValidateFunc: validation.StringInSlice(guardduty.AutoEnableMembers_Values(), false),
 			},
 
 			"datasources": {
@@ -60,6 +71,45 @@ func ResourceOrganizationConfiguration() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+
+			"feature": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"datasources"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"additional_configuration": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_enable": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureAdditionalConfiguration_Values(), false),
+									},
+								},
+							},
+						},
+						"auto_enable": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeatureStatus_Values(), false),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(guardduty.OrgFeature_Values(), false),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -70,14 +120,24 @@ func resourceOrganizationConfigurationUpdate(d *schema.ResourceData, meta interf
 	detectorID := d.Get("detector_id").(string)
 
 	input := &guardduty.UpdateOrganizationConfigurationInput{
-		AutoEnable: aws.Bool(d.Get("auto_enable").(bool)),
 		DetectorId: aws.String(detectorID),
 	}
 
+	// Both arguments are Computed, so use the configuration to tell which one is set.
+	if d.GetRawConfig().GetAttr("auto_enable_organization_members").IsNull() {
+		input.AutoEnable = aws.Bool(d.Get("auto_enable").(bool))
+	} else {
+		input.AutoEnableOrganizationMembers = aws.String(d.Get("auto_enable_organization_members").(string))
+	}
+
 	if v, ok := d.GetOk("datasources"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.DataSources = expandGuardDutyOrganizationDataSourceConfigurations(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if d.HasChange("feature") {
+		input.Features = expandOrganizationFeatureConfigurations(d.Get("feature").(*schema.Set).List())
+	}
+
 	_, err := conn.UpdateOrganizationConfiguration(input)
 
 	if err != nil {
@@ -113,6 +173,7 @@ func resourceOrganizationConfigurationRead(d *schema.ResourceData, meta interfac
 	}
 
 	d.Set("auto_enable", output.AutoEnable)
+	d.Set("auto_enable_organization_members", output.AutoEnableOrganizationMembers)
 
 	if output.DataSources != nil {
 		if err := d.Set("datasources", []interface{}{flattenGuardDutyOrganizationDataSourceConfigurationsResult(output.DataSources)}); err != nil {
@@ -124,6 +185,10 @@ func resourceOrganizationConfigurationRead(d *schema.ResourceData, meta interfac
 
 	d.Set("detector_id", d.Id())
 
+	if err := d.Set("feature", flattenOrganizationFeatureConfigurationResults(filterOrganizationFeatureConfigurationResults(output.Features, d.Get("feature").(*schema.Set).List()))); err != nil {
+		return fmt.Errorf("error setting feature: %w", err)
+	}
+
 	return nil
 }
 
@@ -182,3 +247,128 @@ func flattenGuardDutyOrganizationS3LogsConfigurationResult(apiObject *guardduty.
 
 	return tfMap
 }
+
+func expandOrganizationFeatureConfigurations(tfList []interface{}) []*guardduty.OrganizationFeatureConfiguration {
+	var apiObjects []*guardduty.OrganizationFeatureConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.OrganizationFeatureConfiguration{}
+
+		if v, ok := tfMap["additional_configuration"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AdditionalConfiguration = expandOrganizationAdditionalConfigurations(v.List())
+		}
+
+		if v, ok := tfMap["auto_enable"].(string); ok && v != "" {
+			apiObject.AutoEnable = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOrganizationAdditionalConfigurations(tfList []interface{}) []*guardduty.OrganizationAdditionalConfiguration {
+	var apiObjects []*guardduty.OrganizationAdditionalConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &guardduty.OrganizationAdditionalConfiguration{}
+
+		if v, ok := tfMap["auto_enable"].(string); ok && v != "" {
+			apiObject.AutoEnable = aws.String(v)
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// filterOrganizationFeatureConfigurationResults returns the features, and their additional configuration,
+// that are named in the configured features. All features are returned if none are configured.
+func filterOrganizationFeatureConfigurationResults(apiObjects []*guardduty.OrganizationFeatureConfigurationResult, tfList []interface{}) []*guardduty.OrganizationFeatureConfigurationResult {
+	if len(tfList) == 0 {
+		return apiObjects
+	}
+
+	configured := featureAdditionalConfigurationNames(tfList)
+	var result []*guardduty.OrganizationFeatureConfigurationResult
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		additionalConfigurationNames, ok := configured[aws.StringValue(apiObject.Name)]
+
+		if !ok {
+			continue
+		}
+
+		feature := &guardduty.OrganizationFeatureConfigurationResult{
+			AutoEnable: apiObject.AutoEnable,
+			Name:       apiObject.Name,
+		}
+
+		for _, v := range apiObject.AdditionalConfiguration {
+			if v != nil && additionalConfigurationNames[aws.StringValue(v.Name)] {
+				feature.AdditionalConfiguration = append(feature.AdditionalConfiguration, v)
+			}
+		}
+
+		result = append(result, feature)
+	}
+
+	return result
+}
+
+func flattenOrganizationFeatureConfigurationResults(apiObjects []*guardduty.OrganizationFeatureConfigurationResult) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var additionalConfiguration []interface{}
+
+		for _, v := range apiObject.AdditionalConfiguration {
+			if v == nil {
+				continue
+			}
+
+			additionalConfiguration = append(additionalConfiguration, map[string]interface{}{
+				"auto_enable": aws.StringValue(v.AutoEnable),
+				"name":        aws.StringValue(v.Name),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"additional_configuration": additionalConfiguration,
+			"auto_enable":              aws.StringValue(apiObject.AutoEnable),
+			"name":                     aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
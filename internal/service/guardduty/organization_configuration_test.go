@@ -85,6 +85,107 @@ func testAccOrganizationConfiguration_s3logs(t *testing.T) {
 	})
 }
 
+func testAccOrganizationConfiguration_autoEnableOrganizationMembers(t *testing.T) {
+	detectorResourceName := "aws_guardduty_detector.test"
+	resourceName := "aws_guardduty_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigAutoEnableOrganizationMembers(guardduty.AutoEnableMembersAll),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_organization_members", guardduty.AutoEnableMembersAll),
+					resource.TestCheckResourceAttrPair(resourceName, "detector_id", detectorResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigAutoEnableOrganizationMembers(guardduty.AutoEnableMembersNone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "auto_enable_organization_members", guardduty.AutoEnableMembersNone),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationConfiguration_features(t *testing.T) {
+	resourceName := "aws_guardduty_organization_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationsAccount(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, guardduty.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDetectorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigFeatures(guardduty.OrgFeatureStatusNew),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"auto_enable": guardduty.OrgFeatureStatusNew,
+						"name":        guardduty.OrgFeatureRdsLoginEvents,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"additional_configuration.#":             "1",
+						"additional_configuration.0.auto_enable": guardduty.OrgFeatureStatusNew,
+						"additional_configuration.0.name":        guardduty.OrgFeatureAdditionalConfigurationEksAddonManagement,
+						"auto_enable":                            guardduty.OrgFeatureStatusAll,
+						"name":                                   guardduty.OrgFeatureEksRuntimeMonitoring,
+					}),
+				),
+			},
+			{
+				Config: testAccGuardDutyOrganizationConfigurationConfigFeatures(guardduty.OrgFeatureStatusNone),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "feature.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "feature.*", map[string]string{
+						"auto_enable": guardduty.OrgFeatureStatusNone,
+						"name":        guardduty.OrgFeatureRdsLoginEvents,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardDutyOrganizationConfigurationConfigBase() string {
+	return `
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["guardduty.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_guardduty_detector" "test" {}
+
+resource "aws_guardduty_organization_admin_account" "test" {
+  depends_on = [aws_organizations_organization.test]
+
+  admin_account_id = data.aws_caller_identity.current.account_id
+}
+`
+}
+
 func testAccGuardDutyOrganizationConfigurationConfigAutoEnable(autoEnable bool) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -146,3 +247,40 @@ resource "aws_guardduty_organization_configuration" "test" {
 }
 `, autoEnable)
 }
+
+func testAccGuardDutyOrganizationConfigurationConfigAutoEnableOrganizationMembers(value string) string {
+	return acctest.ConfigCompose(testAccGuardDutyOrganizationConfigurationConfigBase(), fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable_organization_members = %[1]q
+  detector_id                      = aws_guardduty_detector.test.id
+}
+`, value))
+}
+
+func testAccGuardDutyOrganizationConfigurationConfigFeatures(rdsLoginEventsAutoEnable string) string {
+	return acctest.ConfigCompose(testAccGuardDutyOrganizationConfigurationConfigBase(), fmt.Sprintf(`
+resource "aws_guardduty_organization_configuration" "test" {
+  depends_on = [aws_guardduty_organization_admin_account.test]
+
+  auto_enable_organization_members = "NEW"
+  detector_id                      = aws_guardduty_detector.test.id
+
+  feature {
+    name        = "RDS_LOGIN_EVENTS"
+    auto_enable = %[1]q
+  }
+
+  feature {
+    name        = "EKS_RUNTIME_MONITORING"
+    auto_enable = "ALL"
+
+    additional_configuration {
+      name        = "EKS_ADDON_MANAGEMENT"
+      auto_enable = "NEW"
+    }
+  }
+}
+`, rdsLoginEventsAutoEnable))
+}
@@ -0,0 +1,245 @@
+package identitystore
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupCreate,
+		Read:   resourceGroupRead,
+		Update: resourceGroupUpdate,
+		Delete: resourceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"external_ids": externalIDsSchema(),
+
+			"group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity_store_id": identityStoreIDSchema(),
+		},
+	}
+}
+
+func resourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateGroupInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store Group: %s", input)
+	output, err := conn.CreateGroup(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Identity Store Group (%s): %w", d.Get("display_name").(string), err)
+	}
+
+	d.SetId(GroupCreateResourceID(identityStoreID, aws.StringValue(output.GroupId)))
+
+	return resourceGroupRead(d, meta)
+}
+
+func resourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindGroupByTwoPartKey(conn, identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Identity Store Group (%s): %w", d.Id(), err)
+	}
+
+	d.Set("description", output.Description)
+	d.Set("display_name", output.DisplayName)
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return fmt.Errorf("error setting external_ids: %w", err)
+	}
+	d.Set("group_id", output.GroupId)
+	d.Set("identity_store_id", output.IdentityStoreId)
+
+	return nil
+}
+
+func resourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var operations []attributeOperation
+
+	if d.HasChange("description") {
+		operations = append(operations, attributeOperation{
+			AttributePath:  "description",
+			AttributeValue: nullableString(d.Get("description").(string)),
+		})
+	}
+
+	if d.HasChange("display_name") {
+		operations = append(operations, attributeOperation{
+			AttributePath:  "displayName",
+			AttributeValue: d.Get("display_name").(string),
+		})
+	}
+
+	if len(operations) > 0 {
+		log.Printf("[DEBUG] Updating Identity Store Group (%s)", d.Id())
+		if err := updateGroup(conn, identityStoreID, groupID, operations); err != nil {
+			return fmt.Errorf("error updating Identity Store Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceGroupRead(d, meta)
+}
+
+func resourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, groupID, err := GroupParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store Group: %s", d.Id())
+	_, err = conn.DeleteGroup(&identitystore.DeleteGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Identity Store Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const groupResourceIDSeparator = "/"
+
+func GroupCreateResourceID(identityStoreID, groupID string) string {
+	parts := []string{identityStoreID, groupID}
+	id := strings.Join(parts, groupResourceIDSeparator)
+
+	return id
+}
+
+func GroupParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected identity-store-id%[2]sgroup-id", id, groupResourceIDSeparator)
+}
+
+func identityStoreIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.All(
+			validation.StringLenBetween(1, 64),
+			validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-]*$`), "must match [a-zA-Z0-9-]"),
+		),
+	}
+}
+
+func externalIDsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"issuer": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenExternalIDs(apiObjects []*identitystore.ExternalId) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":     aws.StringValue(apiObject.Id),
+			"issuer": aws.StringValue(apiObject.Issuer),
+		})
+	}
+
+	return tfList
+}
+
+// nullableString returns nil for an empty string, so that clearing an optional argument removes the attribute.
+func nullableString(v string) interface{} {
+	if v == "" {
+		return nil
+	}
+
+	return v
+}
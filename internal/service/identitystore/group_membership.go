@@ -0,0 +1,147 @@
+package identitystore
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGroupMembershipCreate,
+		Read:   resourceGroupMembershipRead,
+		Delete: resourceGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"identity_store_id": identityStoreIDSchema(),
+
+			"member_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 47),
+			},
+
+			"membership_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.CreateGroupMembershipInput{
+		GroupId:         aws.String(d.Get("group_id").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		MemberId: &identitystore.MemberId{
+			UserId: aws.String(d.Get("member_id").(string)),
+		},
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store Group Membership: %s", input)
+	output, err := conn.CreateGroupMembership(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Identity Store Group Membership: %w", err)
+	}
+
+	d.SetId(GroupMembershipCreateResourceID(identityStoreID, aws.StringValue(output.MembershipId)))
+
+	return resourceGroupMembershipRead(d, meta)
+}
+
+func resourceGroupMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, membershipID, err := GroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindGroupMembershipByTwoPartKey(conn, identityStoreID, membershipID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store Group Membership (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Identity Store Group Membership (%s): %w", d.Id(), err)
+	}
+
+	d.Set("group_id", output.GroupId)
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("member_id", output.MemberId.UserId)
+	d.Set("membership_id", output.MembershipId)
+
+	return nil
+}
+
+func resourceGroupMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, membershipID, err := GroupMembershipParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store Group Membership: %s", d.Id())
+	_, err = conn.DeleteGroupMembership(&identitystore.DeleteGroupMembershipInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		MembershipId:    aws.String(membershipID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Identity Store Group Membership (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const groupMembershipResourceIDSeparator = "/"
+
+func GroupMembershipCreateResourceID(identityStoreID, membershipID string) string {
+	parts := []string{identityStoreID, membershipID}
+	id := strings.Join(parts, groupMembershipResourceIDSeparator)
+
+	return id
+}
+
+func GroupMembershipParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, groupMembershipResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected identity-store-id%[2]smembership-id", id, groupMembershipResourceIDSeparator)
+}
@@ -0,0 +1,114 @@
+package identitystore
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"attribute_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"identity_store_id": identityStoreIDSchema(),
+
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	input := &identitystore.ListGroupsInput{
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+
+	if v, ok := d.GetOk("filter"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = expandIdentityStoreFilters(v.(*schema.Set).List())
+	}
+
+	var ids []string
+	var tfList []interface{}
+
+	err := conn.ListGroupsPages(input, func(page *identitystore.ListGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, group := range page.Groups {
+			if group == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(group.GroupId))
+			tfList = append(tfList, map[string]interface{}{
+				"description":  aws.StringValue(group.Description),
+				"display_name": aws.StringValue(group.DisplayName),
+				"group_id":     aws.StringValue(group.GroupId),
+			})
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing Identity Store Groups: %w", err)
+	}
+
+	d.SetId(identityStoreID)
+	d.Set("ids", ids)
+
+	if err := d.Set("groups", tfList); err != nil {
+		return fmt.Errorf("error setting groups: %w", err)
+	}
+
+	return nil
+}
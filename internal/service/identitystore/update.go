@@ -0,0 +1,95 @@
+package identitystore
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+)
+
+// attributeOperation replaces the value of a single user or group attribute.
+// A nil AttributeValue removes the attribute.
+//
+// The AWS SDK for Go v1 does not model AttributeValue, a JSON document, so update requests are
+// built and validated by the SDK using only the attribute paths and their body is then replaced
+// with one that includes the values.
+type attributeOperation struct {
+	AttributePath  string
+	AttributeValue interface{}
+}
+
+type updateGroupRequestBody struct {
+	GroupId         string
+	IdentityStoreId string
+	Operations      []attributeOperation
+}
+
+type updateUserRequestBody struct {
+	IdentityStoreId string
+	Operations      []attributeOperation
+	UserId          string
+}
+
+func updateGroup(conn *identitystore.IdentityStore, identityStoreID, groupID string, operations []attributeOperation) error {
+	input := &identitystore.UpdateGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperationPaths(operations),
+	}
+
+	req, _ := conn.UpdateGroupRequest(input)
+	req.Handlers.Build.PushBack(setRequestBody(&updateGroupRequestBody{
+		GroupId:         groupID,
+		IdentityStoreId: identityStoreID,
+		Operations:      operations,
+	}))
+
+	return req.Send()
+}
+
+func updateUser(conn *identitystore.IdentityStore, identityStoreID, userID string, operations []attributeOperation) error {
+	input := &identitystore.UpdateUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperationPaths(operations),
+		UserId:          aws.String(userID),
+	}
+
+	req, _ := conn.UpdateUserRequest(input)
+	req.Handlers.Build.PushBack(setRequestBody(&updateUserRequestBody{
+		IdentityStoreId: identityStoreID,
+		Operations:      operations,
+		UserId:          userID,
+	}))
+
+	return req.Send()
+}
+
+func setRequestBody(body interface{}) func(*request.Request) {
+	return func(r *request.Request) {
+		if r.Error != nil {
+			return
+		}
+
+		b, err := json.Marshal(body)
+
+		if err != nil {
+			r.Error = err
+			return
+		}
+
+		r.SetBufferBody(b)
+	}
+}
+
+func expandAttributeOperationPaths(operations []attributeOperation) []*identitystore.AttributeOperation {
+	apiObjects := make([]*identitystore.AttributeOperation, 0, len(operations))
+
+	for _, operation := range operations {
+		apiObjects = append(apiObjects, &identitystore.AttributeOperation{
+			AttributePath: aws.String(operation.AttributePath),
+		})
+	}
+
+	return apiObjects
+}
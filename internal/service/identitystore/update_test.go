@@ -0,0 +1,51 @@
+package identitystore
+
+import (
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+)
+
+func TestSetRequestBody(t *testing.T) {
+	conn := identitystore.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKID", "SECRET", ""),
+		Region:      aws.String("us-east-1"), //lintignore:AWSAT003
+	})))
+
+	operations := []attributeOperation{
+		{AttributePath: "displayName", AttributeValue: "Jane Doe"},
+		{AttributePath: "title", AttributeValue: nullableString("")},
+		{AttributePath: "emails", AttributeValue: []interface{}{map[string]interface{}{"primary": true, "value": "jane@example.com"}}},
+	}
+
+	req, _ := conn.UpdateUserRequest(&identitystore.UpdateUserInput{
+		IdentityStoreId: aws.String("d-1234567890"),
+		Operations:      expandAttributeOperationPaths(operations),
+		UserId:          aws.String("user-id"),
+	})
+	req.Handlers.Build.PushBack(setRequestBody(&updateUserRequestBody{
+		IdentityStoreId: "d-1234567890",
+		Operations:      operations,
+		UserId:          "user-id",
+	}))
+
+	if err := req.Build(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	body, err := io.ReadAll(req.GetBody())
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"IdentityStoreId":"d-1234567890","Operations":[{"AttributePath":"displayName","AttributeValue":"Jane Doe"},{"AttributePath":"title","AttributeValue":null},{"AttributePath":"emails","AttributeValue":[{"primary":true,"value":"jane@example.com"}]}],"UserId":"user-id"}`
+
+	if got := string(body); got != expected {
+		t.Errorf("expected body %s, got %s", expected, got)
+	}
+}
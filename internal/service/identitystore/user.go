@@ -0,0 +1,621 @@
+package identitystore
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceUserCreate,
+		Read:   resourceUserRead,
+		Update: resourceUserUpdate,
+		Delete: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country":        userAttributeSchema(),
+						"formatted":      userAttributeSchema(),
+						"locality":       userAttributeSchema(),
+						"postal_code":    userAttributeSchema(),
+						"primary":        userPrimarySchema(),
+						"region":         userAttributeSchema(),
+						"street_address": userAttributeSchema(),
+						"type":           userAttributeSchema(),
+					},
+				},
+			},
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+
+			"emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": userPrimarySchema(),
+						"type":    userAttributeSchema(),
+						"value":   userAttributeSchema(),
+					},
+				},
+			},
+
+			"external_ids": externalIDsSchema(),
+
+			"identity_store_id": identityStoreIDSchema(),
+
+			"locale": userAttributeSchema(),
+
+			"name": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"family_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": userAttributeSchema(),
+						"given_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_prefix": userAttributeSchema(),
+						"honorific_suffix": userAttributeSchema(),
+						"middle_name":      userAttributeSchema(),
+					},
+				},
+			},
+
+			"nickname": userAttributeSchema(),
+
+			"phone_numbers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": userPrimarySchema(),
+						"type":    userAttributeSchema(),
+						"value":   userAttributeSchema(),
+					},
+				},
+			},
+
+			"preferred_language": userAttributeSchema(),
+
+			"profile_url": userAttributeSchema(),
+
+			"timezone": userAttributeSchema(),
+
+			"title": userAttributeSchema(),
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+
+			"user_type": userAttributeSchema(),
+		},
+	}
+}
+
+// userStringAttributePaths maps the top-level string arguments of a user to their attribute paths.
+var userStringAttributePaths = map[string]string{
+	"display_name":       "displayName",
+	"locale":             "locale",
+	"nickname":           "nickName",
+	"preferred_language": "preferredLanguage",
+	"profile_url":        "profileUrl",
+	"timezone":           "timezone",
+	"title":              "title",
+	"user_type":          "userType",
+}
+
+// userNameAttributePaths maps the arguments of a user's name to their attribute paths.
+var userNameAttributePaths = map[string]string{
+	"family_name":      "name.familyName",
+	"formatted":        "name.formatted",
+	"given_name":       "name.givenName",
+	"honorific_prefix": "name.honorificPrefix",
+	"honorific_suffix": "name.honorificSuffix",
+	"middle_name":      "name.middleName",
+}
+
+func resourceUserCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	userName := d.Get("user_name").(string)
+	input := &identitystore.CreateUserInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		UserName:        aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Addresses = []*identitystore.Address{expandAddress(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Emails = []*identitystore.Email{expandEmail(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Name = expandName(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("nickname"); ok {
+		input.NickName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PhoneNumbers = []*identitystore.PhoneNumber{expandPhoneNumber(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("preferred_language"); ok {
+		input.PreferredLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("profile_url"); ok {
+		input.ProfileUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		input.UserType = aws.String(v.(string))
+	}
+
+	// Don't log the input, it contains personal information.
+	log.Printf("[DEBUG] Creating Identity Store User: %s", userName)
+	output, err := conn.CreateUser(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Identity Store User (%s): %w", userName, err)
+	}
+
+	d.SetId(UserCreateResourceID(identityStoreID, aws.StringValue(output.UserId)))
+
+	return resourceUserRead(d, meta)
+}
+
+func resourceUserRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindUserByTwoPartKey(conn, identityStoreID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Identity Store User (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("addresses", flattenAddresses(output.Addresses)); err != nil {
+		return fmt.Errorf("error setting addresses: %w", err)
+	}
+	d.Set("display_name", output.DisplayName)
+	if err := d.Set("emails", flattenEmails(output.Emails)); err != nil {
+		return fmt.Errorf("error setting emails: %w", err)
+	}
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return fmt.Errorf("error setting external_ids: %w", err)
+	}
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("locale", output.Locale)
+	if err := d.Set("name", flattenName(output.Name)); err != nil {
+		return fmt.Errorf("error setting name: %w", err)
+	}
+	d.Set("nickname", output.NickName)
+	if err := d.Set("phone_numbers", flattenPhoneNumbers(output.PhoneNumbers)); err != nil {
+		return fmt.Errorf("error setting phone_numbers: %w", err)
+	}
+	d.Set("preferred_language", output.PreferredLanguage)
+	d.Set("profile_url", output.ProfileUrl)
+	d.Set("timezone", output.Timezone)
+	d.Set("title", output.Title)
+	d.Set("user_id", output.UserId)
+	d.Set("user_name", output.UserName)
+	d.Set("user_type", output.UserType)
+
+	return nil
+}
+
+func resourceUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	var operations []attributeOperation
+
+	for k, path := range userStringAttributePaths {
+		if d.HasChange(k) {
+			operations = append(operations, attributeOperation{
+				AttributePath:  path,
+				AttributeValue: nullableString(d.Get(k).(string)),
+			})
+		}
+	}
+
+	for k, path := range userNameAttributePaths {
+		key := fmt.Sprintf("name.0.%s", k)
+
+		if d.HasChange(key) {
+			operations = append(operations, attributeOperation{
+				AttributePath:  path,
+				AttributeValue: nullableString(d.Get(key).(string)),
+			})
+		}
+	}
+
+	if d.HasChange("addresses") {
+		operations = append(operations, attributeOperation{
+			AttributePath:  "addresses",
+			AttributeValue: userAttributeListValue(d.Get("addresses").([]interface{}), addressAttributeValue),
+		})
+	}
+
+	if d.HasChange("emails") {
+		operations = append(operations, attributeOperation{
+			AttributePath:  "emails",
+			AttributeValue: userAttributeListValue(d.Get("emails").([]interface{}), emailAttributeValue),
+		})
+	}
+
+	if d.HasChange("phone_numbers") {
+		operations = append(operations, attributeOperation{
+			AttributePath:  "phoneNumbers",
+			AttributeValue: userAttributeListValue(d.Get("phone_numbers").([]interface{}), emailAttributeValue),
+		})
+	}
+
+	if len(operations) > 0 {
+		log.Printf("[DEBUG] Updating Identity Store User (%s)", d.Id())
+		if err := updateUser(conn, identityStoreID, userID, operations); err != nil {
+			return fmt.Errorf("error updating Identity Store User (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(d, meta)
+}
+
+func resourceUserDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := UserParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store User: %s", d.Id())
+	_, err = conn.DeleteUser(&identitystore.DeleteUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Identity Store User (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const userResourceIDSeparator = "/"
+
+func UserCreateResourceID(identityStoreID, userID string) string {
+	parts := []string{identityStoreID, userID}
+	id := strings.Join(parts, userResourceIDSeparator)
+
+	return id
+}
+
+func UserParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, userResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected identity-store-id%[2]suser-id", id, userResourceIDSeparator)
+}
+
+func userAttributeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringLenBetween(1, 1024),
+	}
+}
+
+func userPrimarySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+	}
+}
+
+func expandAddress(tfMap map[string]interface{}) *identitystore.Address {
+	apiObject := &identitystore.Address{}
+
+	if v, ok := tfMap["country"].(string); ok && v != "" {
+		apiObject.Country = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["locality"].(string); ok && v != "" {
+		apiObject.Locality = aws.String(v)
+	}
+
+	if v, ok := tfMap["postal_code"].(string); ok && v != "" {
+		apiObject.PostalCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["street_address"].(string); ok && v != "" {
+		apiObject.StreetAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEmail(tfMap map[string]interface{}) *identitystore.Email {
+	apiObject := &identitystore.Email{}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandName(tfMap map[string]interface{}) *identitystore.Name {
+	apiObject := &identitystore.Name{}
+
+	if v, ok := tfMap["family_name"].(string); ok && v != "" {
+		apiObject.FamilyName = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["given_name"].(string); ok && v != "" {
+		apiObject.GivenName = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_prefix"].(string); ok && v != "" {
+		apiObject.HonorificPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_suffix"].(string); ok && v != "" {
+		apiObject.HonorificSuffix = aws.String(v)
+	}
+
+	if v, ok := tfMap["middle_name"].(string); ok && v != "" {
+		apiObject.MiddleName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPhoneNumber(tfMap map[string]interface{}) *identitystore.PhoneNumber {
+	apiObject := &identitystore.PhoneNumber{}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAddresses(apiObjects []*identitystore.Address) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"country":        aws.StringValue(apiObject.Country),
+			"formatted":      aws.StringValue(apiObject.Formatted),
+			"locality":       aws.StringValue(apiObject.Locality),
+			"postal_code":    aws.StringValue(apiObject.PostalCode),
+			"primary":        aws.BoolValue(apiObject.Primary),
+			"region":         aws.StringValue(apiObject.Region),
+			"street_address": aws.StringValue(apiObject.StreetAddress),
+			"type":           aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEmails(apiObjects []*identitystore.Email) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"primary": aws.BoolValue(apiObject.Primary),
+			"type":    aws.StringValue(apiObject.Type),
+			"value":   aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenName(apiObject *identitystore.Name) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"family_name":      aws.StringValue(apiObject.FamilyName),
+		"formatted":        aws.StringValue(apiObject.Formatted),
+		"given_name":       aws.StringValue(apiObject.GivenName),
+		"honorific_prefix": aws.StringValue(apiObject.HonorificPrefix),
+		"honorific_suffix": aws.StringValue(apiObject.HonorificSuffix),
+		"middle_name":      aws.StringValue(apiObject.MiddleName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPhoneNumbers(apiObjects []*identitystore.PhoneNumber) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"primary": aws.BoolValue(apiObject.Primary),
+			"type":    aws.StringValue(apiObject.Type),
+			"value":   aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+// userAttributeListValue converts a list of user attribute blocks to the value of a multi-valued attribute.
+// An empty list removes the attribute.
+func userAttributeListValue(tfList []interface{}, f func(map[string]interface{}) map[string]interface{}) interface{} {
+	var values []interface{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		values = append(values, f(tfMap))
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
+
+func addressAttributeValue(tfMap map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"country":       nullableString(tfMap["country"].(string)),
+		"formatted":     nullableString(tfMap["formatted"].(string)),
+		"locality":      nullableString(tfMap["locality"].(string)),
+		"postalCode":    nullableString(tfMap["postal_code"].(string)),
+		"primary":       tfMap["primary"].(bool),
+		"region":        nullableString(tfMap["region"].(string)),
+		"streetAddress": nullableString(tfMap["street_address"].(string)),
+		"type":          nullableString(tfMap["type"].(string)),
+	}
+}
+
+// emailAttributeValue converts an email or phone number block to an attribute value. Both have the same shape.
+func emailAttributeValue(tfMap map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"primary": tfMap["primary"].(bool),
+		"type":    nullableString(tfMap["type"].(string)),
+		"value":   nullableString(tfMap["value"].(string)),
+	}
+}
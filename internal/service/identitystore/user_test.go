@@ -0,0 +1,273 @@
+package identitystore_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfidentitystore "github.com/hashicorp/terraform-provider-aws/internal/service/identitystore"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIdentityStoreUser_basic(t *testing.T) {
+	var user identitystore.DescribeUserOutput
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "external_ids.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "identity_store_id", "data.aws_ssoadmin_instances.test", "identity_store_ids.0"),
+					resource.TestCheckResourceAttr(resourceName, "name.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.family_name", "Test"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.given_name", "Acceptance"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "user_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_disappears(t *testing.T) {
+	var user identitystore.DescribeUserOutput
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					acctest.CheckResourceDisappears(acctest.Provider, tfidentitystore.ResourceUser(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityStoreUser_full(t *testing.T) {
+	var user identitystore.DescribeUserOutput
+	resourceName := "aws_identitystore_user.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckSSOAdminInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, identitystore.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_full(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.country", "US"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.locality", "Seattle 1"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.primary", "true"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test 1"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.type", "work"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", "test1@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "locale", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", "Middle 1"),
+					resource.TestCheckResourceAttr(resourceName, "nickname", "Nick 1"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.0.value", "+1 555-0101"),
+					resource.TestCheckResourceAttr(resourceName, "preferred_language", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "profile_url", "https://example.com/1"),
+					resource.TestCheckResourceAttr(resourceName, "timezone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Title 1"),
+					resource.TestCheckResourceAttr(resourceName, "user_type", "Member"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccUserConfig_full(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.0.locality", "Seattle 2"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test 2"),
+					resource.TestCheckResourceAttr(resourceName, "emails.0.value", "test2@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", "Middle 2"),
+					resource.TestCheckResourceAttr(resourceName, "nickname", "Nick 2"),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.0.value", "+1 555-0102"),
+					resource.TestCheckResourceAttr(resourceName, "profile_url", "https://example.com/2"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Title 2"),
+				),
+			},
+			// Removing the optional arguments removes the attributes.
+			{
+				Config: testAccUserConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserExists(resourceName, &user),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "display_name", "Acceptance Test"),
+					resource.TestCheckResourceAttr(resourceName, "emails.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "locale", ""),
+					resource.TestCheckResourceAttr(resourceName, "name.0.middle_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "nickname", ""),
+					resource.TestCheckResourceAttr(resourceName, "phone_numbers.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "title", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_identitystore_user" {
+			continue
+		}
+
+		identityStoreID, userID, err := tfidentitystore.UserParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfidentitystore.FindUserByTwoPartKey(conn, identityStoreID, userID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Identity Store User %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckUserExists(n string, v *identitystore.DescribeUserOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Identity Store User ID is set")
+		}
+
+		identityStoreID, userID, err := tfidentitystore.UserParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IdentityStoreConn
+
+		output, err := tfidentitystore.FindUserByTwoPartKey(conn, identityStoreID, userID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccUserConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = "Acceptance Test"
+  user_name         = %[1]q
+
+  name {
+    family_name = "Test"
+    given_name  = "Acceptance"
+  }
+}
+`, rName)
+}
+
+func testAccUserConfig_full(rName, suffix string) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_user" "test" {
+  identity_store_id  = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name       = "Acceptance Test %[2]s"
+  user_name          = %[1]q
+  locale             = "en-US"
+  nickname           = "Nick %[2]s"
+  preferred_language = "en-US"
+  profile_url        = "https://example.com/%[2]s"
+  timezone           = "America/Los_Angeles"
+  title              = "Title %[2]s"
+  user_type          = "Member"
+
+  name {
+    family_name = "Test"
+    given_name  = "Acceptance"
+    middle_name = "Middle %[2]s"
+  }
+
+  addresses {
+    country        = "US"
+    locality       = "Seattle %[2]s"
+    postal_code    = "98101"
+    primary        = true
+    region         = "WA"
+    street_address = "123 Any Street"
+    type           = "work"
+  }
+
+  emails {
+    primary = true
+    type    = "work"
+    value   = "test%[2]s@example.com"
+  }
+
+  phone_numbers {
+    primary = true
+    type    = "work"
+    value   = "+1 555-010%[2]s"
+  }
+}
+`, rName, suffix)
+}
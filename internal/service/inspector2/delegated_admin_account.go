@@ -0,0 +1,124 @@
+package inspector2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDelegatedAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDelegatedAdminAccountCreate,
+		ReadContext:   resourceDelegatedAdminAccountRead,
+		DeleteContext: resourceDelegatedAdminAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(delegatedAdminAccountEnabledTimeout),
+			Delete: schema.DefaultTimeout(delegatedAdminAccountDisabledTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"relationship_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDelegatedAdminAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	accountID := d.Get("account_id").(string)
+	input := &inspector2.EnableDelegatedAdminAccountInput{
+		ClientToken:             aws.String(resource.UniqueId()),
+		DelegatedAdminAccountId: aws.String(accountID),
+	}
+
+	log.Printf("[DEBUG] Enabling Inspector2 Delegated Admin Account: %s", input)
+	_, err := conn.EnableDelegatedAdminAccountWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error enabling Inspector2 Delegated Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := waitDelegatedAdminAccountEnabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for Inspector2 Delegated Admin Account (%s) enable: %s", d.Id(), err)
+	}
+
+	return resourceDelegatedAdminAccountRead(ctx, d, meta)
+}
+
+func resourceDelegatedAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	output, err := FindDelegatedAdminAccountByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Inspector2 Delegated Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Inspector2 Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("account_id", output.AccountId)
+
+	admin, err := FindDelegatedAdminByID(ctx, conn, d.Id())
+
+	switch {
+	case tfresource.NotFound(err):
+		d.Set("relationship_status", nil)
+	case err != nil:
+		return diag.Errorf("error reading Inspector2 Delegated Admin Account (%s) relationship: %s", d.Id(), err)
+	default:
+		d.Set("relationship_status", admin.RelationshipStatus)
+	}
+
+	return nil
+}
+
+func resourceDelegatedAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).Inspector2Conn
+
+	log.Printf("[DEBUG] Disabling Inspector2 Delegated Admin Account: %s", d.Id())
+	_, err := conn.DisableDelegatedAdminAccountWithContext(ctx, &inspector2.DisableDelegatedAdminAccountInput{
+		DelegatedAdminAccountId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error disabling Inspector2 Delegated Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if err := waitDelegatedAdminAccountDisabled(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for Inspector2 Delegated Admin Account (%s) disable: %s", d.Id(), err)
+	}
+
+	return nil
+}
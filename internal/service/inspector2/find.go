@@ -0,0 +1,76 @@
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindDelegatedAdminAccountByID(ctx context.Context, conn *inspector2.Inspector2, accountID string) (*inspector2.DelegatedAdminAccount, error) {
+	input := &inspector2.ListDelegatedAdminAccountsInput{}
+	var result *inspector2.DelegatedAdminAccount
+
+	err := conn.ListDelegatedAdminAccountsPagesWithContext(ctx, input, func(page *inspector2.ListDelegatedAdminAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, account := range page.DelegatedAdminAccounts {
+			if account == nil {
+				continue
+			}
+
+			if aws.StringValue(account.AccountId) == accountID {
+				result = account
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+// FindDelegatedAdminByID returns the organization's delegated administrator, including its relationship status.
+func FindDelegatedAdminByID(ctx context.Context, conn *inspector2.Inspector2, accountID string) (*inspector2.DelegatedAdmin, error) {
+	input := &inspector2.GetDelegatedAdminAccountInput{}
+
+	output, err := conn.GetDelegatedAdminAccountWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, inspector2.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DelegatedAdmin == nil || aws.StringValue(output.DelegatedAdmin.AccountId) != accountID {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DelegatedAdmin, nil
+}
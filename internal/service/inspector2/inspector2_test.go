@@ -0,0 +1,26 @@
+package inspector2_test
+
+import (
+	"testing"
+)
+
+func TestAccInspector2_serial(t *testing.T) {
+	testCases := map[string]map[string]func(t *testing.T){
+		"DelegatedAdminAccount": {
+			"basic":      testAccDelegatedAdminAccount_basic,
+			"disappears": testAccDelegatedAdminAccount_disappears,
+		},
+	}
+
+	for group, m := range testCases {
+		m := m
+		t.Run(group, func(t *testing.T) {
+			for name, tc := range m {
+				tc := tc
+				t.Run(name, func(t *testing.T) {
+					tc(t)
+				})
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package inspector2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDelegatedAdminAccount(ctx context.Context, conn *inspector2.Inspector2, accountID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDelegatedAdminAccountByID(ctx, conn, accountID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -0,0 +1,45 @@
+package inspector2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/inspector2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	delegatedAdminAccountEnabledTimeout  = 5 * time.Minute
+	delegatedAdminAccountDisabledTimeout = 5 * time.Minute
+)
+
+func waitDelegatedAdminAccountEnabled(ctx context.Context, conn *inspector2.Inspector2, accountID string, timeout time.Duration) (*inspector2.DelegatedAdminAccount, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{inspector2.DelegatedAdminStatusEnabled},
+		Refresh:                   statusDelegatedAdminAccount(ctx, conn, accountID),
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*inspector2.DelegatedAdminAccount); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDelegatedAdminAccountDisabled(ctx context.Context, conn *inspector2.Inspector2, accountID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{inspector2.DelegatedAdminStatusDisableInProgress, inspector2.DelegatedAdminStatusEnabled},
+		Target:  []string{},
+		Refresh: statusDelegatedAdminAccount(ctx, conn, accountID),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
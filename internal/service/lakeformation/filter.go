@@ -31,6 +31,14 @@ func FilterPermissions(input *lakeformation.ListPermissionsInput, tableType stri
 		return FilterDatabasePermissions(input.Principal.DataLakePrincipalIdentifier, allPermissions)
 	}
 
+	if input.Resource.LFTag != nil {
+		return FilterLFTagPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.LFTag, allPermissions)
+	}
+
+	if input.Resource.LFTagPolicy != nil {
+		return FilterLFTagPolicyPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.LFTagPolicy, allPermissions)
+	}
+
 	if tableType == TableTypeTableWithColumns {
 		return FilterTableWithColumnsPermissions(input.Principal.DataLakePrincipalIdentifier, input.Resource.Table, columnNames, excludedColumnNames, columnWildcard, allPermissions)
 	}
@@ -176,3 +184,68 @@ func FilterDatabasePermissions(principal *string, allPermissions []*lakeformatio
 
 	return cleanPermissions
 }
+
+func FilterLFTagPermissions(principal *string, lfTag *lakeformation.LFTagKeyResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if perm.Resource.LFTag == nil || aws.StringValue(perm.Resource.LFTag.TagKey) != aws.StringValue(lfTag.TagKey) {
+			continue
+		}
+
+		if StringSlicesEqualIgnoreOrder(perm.Resource.LFTag.TagValues, lfTag.TagValues) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+func FilterLFTagPolicyPermissions(principal *string, lfTagPolicy *lakeformation.LFTagPolicyResource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*lakeformation.PrincipalResourcePermissions {
+	// Permissions granted on an LF-Tag expression are only the same grant if the resource type
+	// and every key and its values match; the order of the expression is not significant.
+
+	var cleanPermissions []*lakeformation.PrincipalResourcePermissions
+
+	for _, perm := range allPermissions {
+		if aws.StringValue(principal) != aws.StringValue(perm.Principal.DataLakePrincipalIdentifier) {
+			continue
+		}
+
+		if perm.Resource.LFTagPolicy == nil || aws.StringValue(perm.Resource.LFTagPolicy.ResourceType) != aws.StringValue(lfTagPolicy.ResourceType) {
+			continue
+		}
+
+		if LFTagExpressionsEqual(perm.Resource.LFTagPolicy.Expression, lfTagPolicy.Expression) {
+			cleanPermissions = append(cleanPermissions, perm)
+		}
+	}
+
+	return cleanPermissions
+}
+
+func LFTagExpressionsEqual(e1, e2 []*lakeformation.LFTag) bool {
+	if len(e1) != len(e2) {
+		return false
+	}
+
+	m := make(map[string][]*string, len(e1))
+
+	for _, lfTag := range e1 {
+		m[aws.StringValue(lfTag.TagKey)] = lfTag.TagValues
+	}
+
+	for _, lfTag := range e2 {
+		values, ok := m[aws.StringValue(lfTag.TagKey)]
+
+		if !ok || !StringSlicesEqualIgnoreOrder(values, lfTag.TagValues) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -534,6 +534,165 @@ func TestFilterPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "lfTag",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					LFTag: &lakeformation.LFTagKeyResource{
+						CatalogId: aws.String(accountID),
+						TagKey:    aws.String("classification"),
+						TagValues: aws.StringSlice([]string{"public", "private"}),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("classification"),
+							TagValues: aws.StringSlice([]string{"private", "public"}),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionAssociate}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("classification"),
+							TagValues: aws.StringSlice([]string{"public"}),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTag: &lakeformation.LFTagKeyResource{
+							CatalogId: aws.String(accountID),
+							TagKey:    aws.String("classification"),
+							TagValues: aws.StringSlice([]string{"private", "public"}),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "lfTagPolicy",
+			Input: &lakeformation.ListPermissionsInput{
+				Principal: principal,
+				Resource: &lakeformation.Resource{
+					LFTagPolicy: &lakeformation.LFTagPolicyResource{
+						CatalogId: aws.String(accountID),
+						Expression: []*lakeformation.LFTag{
+							{
+								TagKey:    aws.String("classification"),
+								TagValues: aws.StringSlice([]string{"public"}),
+							},
+							{
+								TagKey:    aws.String("domain"),
+								TagValues: aws.StringSlice([]string{"sales", "marketing"}),
+							},
+						},
+						ResourceType: aws.String(lakeformation.ResourceTypeTable),
+					},
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("domain"),
+									TagValues: aws.StringSlice([]string{"marketing", "sales"}),
+								},
+								{
+									TagKey:    aws.String("classification"),
+									TagValues: aws.StringSlice([]string{"public"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDescribe}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("classification"),
+									TagValues: aws.StringSlice([]string{"public"}),
+								},
+								{
+									TagKey:    aws.String("domain"),
+									TagValues: aws.StringSlice([]string{"sales", "marketing"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeDatabase),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("classification"),
+									TagValues: aws.StringSlice([]string{"public"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+						},
+					},
+				},
+			},
+			ExpectedClean: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  principal,
+					Resource: &lakeformation.Resource{
+						LFTagPolicy: &lakeformation.LFTagPolicyResource{
+							CatalogId: aws.String(accountID),
+							Expression: []*lakeformation.LFTag{
+								{
+									TagKey:    aws.String("domain"),
+									TagValues: aws.StringSlice([]string{"marketing", "sales"}),
+								},
+								{
+									TagKey:    aws.String("classification"),
+									TagValues: aws.StringSlice([]string{"public"}),
+								},
+							},
+							ResourceType: aws.String(lakeformation.ResourceTypeTable),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
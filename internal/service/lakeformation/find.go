@@ -0,0 +1,123 @@
+package lakeformation
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindLFTag(conn *lakeformation.LakeFormation, catalogID, key string) (*lakeformation.GetLFTagOutput, error) {
+	input := &lakeformation.GetLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+	}
+
+	output, err := conn.GetLFTag(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func FindResourceLFTags(conn *lakeformation.LakeFormation, input *lakeformation.GetResourceLFTagsInput) (*lakeformation.GetResourceLFTagsOutput, error) {
+	output, err := conn.GetResourceLFTags(input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// FindPermissionsByResource returns the permissions of every principal on a Lake Formation resource.
+func FindPermissionsByResource(conn *lakeformation.LakeFormation, catalogID string, lfResource *lakeformation.Resource) ([]*lakeformation.PrincipalResourcePermissions, error) {
+	input := &lakeformation.ListPermissionsInput{
+		Resource: lfResource,
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	var permissions []*lakeformation.PrincipalResourcePermissions
+
+	err := conn.ListPermissionsPages(input, func(page *lakeformation.ListPermissionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, permission := range page.PrincipalResourcePermissions {
+			if permission != nil {
+				permissions = append(permissions, permission)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) || tfawserr.ErrMessageContains(err, "AccessDeniedException", "Resource does not exist") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+// FindDataLakeAdmins returns the identifiers of the data lake administrators of a Data Catalog.
+func FindDataLakeAdmins(conn *lakeformation.LakeFormation, catalogID string) ([]string, error) {
+	input := &lakeformation.GetDataLakeSettingsInput{}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	output, err := conn.GetDataLakeSettings(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var admins []string
+
+	if output != nil && output.DataLakeSettings != nil {
+		for _, admin := range output.DataLakeSettings.DataLakeAdmins {
+			if admin != nil {
+				admins = append(admins, aws.StringValue(admin.DataLakePrincipalIdentifier))
+			}
+		}
+	}
+
+	return admins, nil
+}
@@ -0,0 +1,233 @@
+package lakeformation
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+)
+
+// ResourceGrant is the set of permissions that one principal holds on a database or table.
+// For a table, the grant may be restricted to columns with ColumnNames or ExcludedColumnNames.
+type ResourceGrant struct {
+	ColumnNames                []string
+	ExcludedColumnNames        []string
+	Permissions                []string
+	PermissionsWithGrantOption []string
+	Principal                  string
+}
+
+// key identifies the principal and column scope of a grant.
+func (g *ResourceGrant) key() string {
+	return strings.Join([]string{
+		g.Principal,
+		strings.Join(g.ColumnNames, ","),
+		strings.Join(g.ExcludedColumnNames, ","),
+	}, "|")
+}
+
+// GrantsForResource aggregates the permissions listed for a database or table into one grant per
+// principal and column scope. As with FilterPermissions, Lake Formation returns SELECT on a table
+// as SELECT on every column of the table; those permissions are folded back into the table-level
+// grant. Permissions on other resources, such as every table in a database, are ignored.
+func GrantsForResource(resource *lakeformation.Resource, allPermissions []*lakeformation.PrincipalResourcePermissions) []*ResourceGrant {
+	grants := make(map[string]*ResourceGrant)
+	var keys []string
+
+	for _, perm := range allPermissions {
+		if perm == nil || perm.Principal == nil || perm.Resource == nil {
+			continue
+		}
+
+		grant := &ResourceGrant{
+			Principal: aws.StringValue(perm.Principal.DataLakePrincipalIdentifier),
+		}
+
+		switch {
+		case resource.Database != nil:
+			if perm.Resource.Database == nil || aws.StringValue(perm.Resource.Database.Name) != aws.StringValue(resource.Database.Name) {
+				continue
+			}
+		case resource.Table != nil:
+			if perm.Resource.Table != nil {
+				if perm.Resource.Table.TableWildcard != nil || aws.StringValue(perm.Resource.Table.DatabaseName) != aws.StringValue(resource.Table.DatabaseName) || aws.StringValue(perm.Resource.Table.Name) != aws.StringValue(resource.Table.Name) {
+					continue
+				}
+			} else if twc := perm.Resource.TableWithColumns; twc != nil {
+				if aws.StringValue(twc.DatabaseName) != aws.StringValue(resource.Table.DatabaseName) || aws.StringValue(twc.Name) != aws.StringValue(resource.Table.Name) {
+					continue
+				}
+
+				if twc.ColumnWildcard != nil {
+					grant.ExcludedColumnNames = sortedStrings(twc.ColumnWildcard.ExcludedColumnNames)
+				} else {
+					grant.ColumnNames = sortedStrings(twc.ColumnNames)
+				}
+			} else {
+				continue
+			}
+		default:
+			continue
+		}
+
+		k := grant.key()
+
+		if v, ok := grants[k]; ok {
+			grant = v
+		} else {
+			grants[k] = grant
+			keys = append(keys, k)
+		}
+
+		grant.Permissions = unionStrings(grant.Permissions, aws.StringValueSlice(perm.Permissions))
+		grant.PermissionsWithGrantOption = unionStrings(grant.PermissionsWithGrantOption, aws.StringValueSlice(perm.PermissionsWithGrantOption))
+	}
+
+	sort.Strings(keys)
+
+	var result []*ResourceGrant
+
+	for _, k := range keys {
+		result = append(result, grants[k])
+	}
+
+	return result
+}
+
+// DiffGrants returns the permissions that must be revoked and then granted so that the principals
+// hold exactly the new grants. Grants for the same principal and column scope are only changed by
+// the difference in permissions.
+func DiffGrants(old, new []*ResourceGrant) ([]*ResourceGrant, []*ResourceGrant) {
+	oldGrants := make(map[string]*ResourceGrant)
+
+	for _, grant := range old {
+		oldGrants[grant.key()] = grant
+	}
+
+	newGrants := make(map[string]*ResourceGrant)
+
+	for _, grant := range new {
+		newGrants[grant.key()] = grant
+	}
+
+	var revoke, grant []*ResourceGrant
+
+	for _, o := range old {
+		n, ok := newGrants[o.key()]
+
+		if !ok {
+			n = &ResourceGrant{}
+		}
+
+		permissions := subtractStrings(o.Permissions, n.Permissions)
+		permissionsWithGrantOption := subtractStrings(o.PermissionsWithGrantOption, n.PermissionsWithGrantOption)
+
+		if len(permissions) > 0 || len(permissionsWithGrantOption) > 0 {
+			revoke = append(revoke, &ResourceGrant{
+				ColumnNames:                o.ColumnNames,
+				ExcludedColumnNames:        o.ExcludedColumnNames,
+				Permissions:                permissions,
+				PermissionsWithGrantOption: permissionsWithGrantOption,
+				Principal:                  o.Principal,
+			})
+		}
+	}
+
+	for _, n := range new {
+		o, ok := oldGrants[n.key()]
+
+		if !ok {
+			o = &ResourceGrant{}
+		}
+
+		permissionsWithGrantOption := subtractStrings(n.PermissionsWithGrantOption, o.PermissionsWithGrantOption)
+		// Permissions with grant option must also be listed in the permissions being granted.
+		permissions := unionStrings(subtractStrings(n.Permissions, o.Permissions), permissionsWithGrantOption)
+
+		if len(permissions) > 0 {
+			grant = append(grant, &ResourceGrant{
+				ColumnNames:                n.ColumnNames,
+				ExcludedColumnNames:        n.ExcludedColumnNames,
+				Permissions:                permissions,
+				PermissionsWithGrantOption: permissionsWithGrantOption,
+				Principal:                  n.Principal,
+			})
+		}
+	}
+
+	return revoke, grant
+}
+
+// expandGrantResource returns the Lake Formation resource that a grant on the given database or table applies to.
+func expandGrantResource(resource *lakeformation.Resource, grant *ResourceGrant) *lakeformation.Resource {
+	if resource.Table == nil || (len(grant.ColumnNames) == 0 && len(grant.ExcludedColumnNames) == 0) {
+		return resource
+	}
+
+	apiObject := &lakeformation.TableWithColumnsResource{
+		CatalogId:    resource.Table.CatalogId,
+		DatabaseName: resource.Table.DatabaseName,
+		Name:         resource.Table.Name,
+	}
+
+	if len(grant.ExcludedColumnNames) > 0 {
+		apiObject.ColumnWildcard = &lakeformation.ColumnWildcard{
+			ExcludedColumnNames: aws.StringSlice(grant.ExcludedColumnNames),
+		}
+	} else {
+		apiObject.ColumnNames = aws.StringSlice(grant.ColumnNames)
+	}
+
+	return &lakeformation.Resource{
+		TableWithColumns: apiObject,
+	}
+}
+
+func sortedStrings(s []*string) []string {
+	v := aws.StringValueSlice(s)
+
+	sort.Strings(v)
+
+	return v
+}
+
+// unionStrings returns the sorted, distinct strings in both slices.
+func unionStrings(s1, s2 []string) []string {
+	m := make(map[string]struct{})
+
+	for _, v := range append(append([]string{}, s1...), s2...) {
+		m[v] = struct{}{}
+	}
+
+	var result []string
+
+	for v := range m {
+		result = append(result, v)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+// subtractStrings returns the sorted strings in s1 that are not in s2.
+func subtractStrings(s1, s2 []string) []string {
+	m := make(map[string]struct{})
+
+	for _, v := range s2 {
+		m[v] = struct{}{}
+	}
+
+	var result []string
+
+	for _, v := range s1 {
+		if _, ok := m[v]; !ok {
+			result = append(result, v)
+		}
+	}
+
+	sort.Strings(result)
+
+	return result
+}
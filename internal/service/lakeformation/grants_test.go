@@ -0,0 +1,262 @@
+package lakeformation_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+)
+
+func TestGrantsForResource(t *testing.T) {
+	dbName := "Hiliji"
+	tableName := "Ladocmoc"
+	//lintignore:AWSAT005
+	principal1 := "arn:aws:iam::481516234248:role/Zepotiz-Bulgaria"
+	//lintignore:AWSAT005
+	principal2 := "arn:aws:iam::481516234248:role/Bulgaria-Zepotiz"
+
+	table := &lakeformation.Resource{
+		Table: &lakeformation.TableResource{
+			DatabaseName: aws.String(dbName),
+			Name:         aws.String(tableName),
+		},
+	}
+
+	testCases := []struct {
+		Name     string
+		Resource *lakeformation.Resource
+		All      []*lakeformation.PrincipalResourcePermissions
+		Expected []*tflakeformation.ResourceGrant
+	}{
+		{
+			Name:     "empty",
+			Resource: table,
+		},
+		{
+			Name: "database",
+			Resource: &lakeformation.Resource{
+				Database: &lakeformation.DatabaseResource{
+					Name: aws.String(dbName),
+				},
+			},
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionAll}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{}),
+					Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(tflakeformation.IAMAllowedPrincipals)},
+					Resource: &lakeformation.Resource{
+						Database: &lakeformation.DatabaseResource{
+							Name: aws.String(dbName),
+						},
+					},
+				},
+				{
+					Permissions:                aws.StringSlice([]string{lakeformation.PermissionDrop, lakeformation.PermissionAlter}),
+					PermissionsWithGrantOption: aws.StringSlice([]string{lakeformation.PermissionAlter}),
+					Principal:                  &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal1)},
+					Resource: &lakeformation.Resource{
+						Database: &lakeformation.DatabaseResource{
+							Name: aws.String(dbName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal2)},
+					Resource: &lakeformation.Resource{
+						Table: &lakeformation.TableResource{
+							DatabaseName:  aws.String(dbName),
+							Name:          aws.String(tflakeformation.TableNameAllTables),
+							TableWildcard: &lakeformation.TableWildcard{},
+						},
+					},
+				},
+			},
+			Expected: []*tflakeformation.ResourceGrant{
+				{
+					Permissions: []string{lakeformation.PermissionAll},
+					Principal:   tflakeformation.IAMAllowedPrincipals,
+				},
+				{
+					Permissions:                []string{lakeformation.PermissionAlter, lakeformation.PermissionDrop},
+					PermissionsWithGrantOption: []string{lakeformation.PermissionAlter},
+					Principal:                  principal1,
+				},
+			},
+		},
+		{
+			Name:     "table",
+			Resource: table,
+			All: []*lakeformation.PrincipalResourcePermissions{
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionAlter}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal1)},
+					Resource: &lakeformation.Resource{
+						Table: &lakeformation.TableResource{
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal1)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							ColumnWildcard: &lakeformation.ColumnWildcard{},
+							DatabaseName:   aws.String(dbName),
+							Name:           aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal2)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							ColumnNames:  aws.StringSlice([]string{"timestamp", "event"}),
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal2)},
+					Resource: &lakeformation.Resource{
+						TableWithColumns: &lakeformation.TableWithColumnsResource{
+							ColumnWildcard: &lakeformation.ColumnWildcard{
+								ExcludedColumnNames: aws.StringSlice([]string{"value"}),
+							},
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String(tableName),
+						},
+					},
+				},
+				{
+					Permissions: aws.StringSlice([]string{lakeformation.PermissionSelect}),
+					Principal:   &lakeformation.DataLakePrincipal{DataLakePrincipalIdentifier: aws.String(principal2)},
+					Resource: &lakeformation.Resource{
+						Table: &lakeformation.TableResource{
+							DatabaseName: aws.String(dbName),
+							Name:         aws.String("Other"),
+						},
+					},
+				},
+			},
+			Expected: []*tflakeformation.ResourceGrant{
+				{
+					ColumnNames: []string{"event", "timestamp"},
+					Permissions: []string{lakeformation.PermissionSelect},
+					Principal:   principal2,
+				},
+				{
+					ExcludedColumnNames: []string{"value"},
+					Permissions:         []string{lakeformation.PermissionSelect},
+					Principal:           principal2,
+				},
+				{
+					Permissions: []string{lakeformation.PermissionAlter, lakeformation.PermissionSelect},
+					Principal:   principal1,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := tflakeformation.GrantsForResource(testCase.Resource, testCase.All)
+
+			if !reflect.DeepEqual(testCase.Expected, got) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDiffGrants(t *testing.T) {
+	//lintignore:AWSAT005
+	principal1 := "arn:aws:iam::481516234248:role/Zepotiz-Bulgaria"
+	//lintignore:AWSAT005
+	principal2 := "arn:aws:iam::481516234248:role/Bulgaria-Zepotiz"
+
+	testCases := []struct {
+		Name           string
+		Old            []*tflakeformation.ResourceGrant
+		New            []*tflakeformation.ResourceGrant
+		ExpectedRevoke []*tflakeformation.ResourceGrant
+		ExpectedGrant  []*tflakeformation.ResourceGrant
+	}{
+		{
+			Name: "empty",
+		},
+		{
+			Name: "unchanged",
+			Old: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAlter}, Principal: principal1},
+			},
+			New: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAlter}, Principal: principal1},
+			},
+		},
+		{
+			Name: "revoke unmanaged principal",
+			Old: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAll}, Principal: tflakeformation.IAMAllowedPrincipals},
+				{Permissions: []string{lakeformation.PermissionAlter}, Principal: principal1},
+			},
+			New: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAlter}, Principal: principal1},
+			},
+			ExpectedRevoke: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAll}, Principal: tflakeformation.IAMAllowedPrincipals},
+			},
+		},
+		{
+			Name: "change permissions",
+			Old: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAlter, lakeformation.PermissionDrop}, PermissionsWithGrantOption: []string{lakeformation.PermissionDrop}, Principal: principal1},
+			},
+			New: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAlter, lakeformation.PermissionSelect}, PermissionsWithGrantOption: []string{lakeformation.PermissionAlter}, Principal: principal1},
+			},
+			ExpectedRevoke: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionDrop}, PermissionsWithGrantOption: []string{lakeformation.PermissionDrop}, Principal: principal1},
+			},
+			ExpectedGrant: []*tflakeformation.ResourceGrant{
+				{Permissions: []string{lakeformation.PermissionAlter, lakeformation.PermissionSelect}, PermissionsWithGrantOption: []string{lakeformation.PermissionAlter}, Principal: principal1},
+			},
+		},
+		{
+			Name: "column scope",
+			Old: []*tflakeformation.ResourceGrant{
+				{ColumnNames: []string{"event"}, Permissions: []string{lakeformation.PermissionSelect}, Principal: principal2},
+			},
+			New: []*tflakeformation.ResourceGrant{
+				{ColumnNames: []string{"event", "timestamp"}, Permissions: []string{lakeformation.PermissionSelect}, Principal: principal2},
+			},
+			ExpectedRevoke: []*tflakeformation.ResourceGrant{
+				{ColumnNames: []string{"event"}, Permissions: []string{lakeformation.PermissionSelect}, Principal: principal2},
+			},
+			ExpectedGrant: []*tflakeformation.ResourceGrant{
+				{ColumnNames: []string{"event", "timestamp"}, Permissions: []string{lakeformation.PermissionSelect}, Principal: principal2},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			revoke, grant := tflakeformation.DiffGrants(testCase.Old, testCase.New)
+
+			if !reflect.DeepEqual(testCase.ExpectedRevoke, revoke) {
+				t.Errorf("got revoke %v, expected %v", revoke, testCase.ExpectedRevoke)
+			}
+
+			if !reflect.DeepEqual(testCase.ExpectedGrant, grant) {
+				t.Errorf("got grant %v, expected %v", grant, testCase.ExpectedGrant)
+			}
+		})
+	}
+}
@@ -12,6 +12,11 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"disappears":       testAccDataLakeSettings_disappears,
 			"withoutCatalogId": testAccDataLakeSettings_withoutCatalogID,
 		},
+		"LFTag": {
+			"basic":      testAccLFTag_basic,
+			"disappears": testAccLFTag_disappears,
+			"values":     testAccLFTag_values,
+		},
 		"PermissionsBasic": {
 			"basic":              testAccPermissions_basic,
 			"database":           testAccPermissions_database,
@@ -19,6 +24,8 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"databaseMultiple":   testAccPermissions_databaseMultiple,
 			"dataLocation":       testAccPermissions_dataLocation,
 			"disappears":         testAccPermissions_disappears,
+			"lfTag":              testAccPermissions_lfTag,
+			"lfTagPolicy":        testAccPermissions_lfTagPolicy,
 		},
 		"PermissionsDataSource": {
 			"basic":            testAccPermissionsDataSource_basic,
@@ -45,6 +52,22 @@ func TestAccLakeFormation_serial(t *testing.T) {
 			"wildcardSelectOnly":      testAccPermissions_twcWildcardSelectOnly,
 			"wildcardSelectPlus":      testAccPermissions_twcWildcardSelectPlus,
 		},
+		"ResourcePermissions": {
+			"database":         testAccResourcePermissions_database,
+			"disappears":       testAccResourcePermissions_disappears,
+			"revokeUnmanaged":  testAccResourcePermissions_revokeUnmanaged,
+			"tableWithColumns": testAccResourcePermissions_tableWithColumns,
+		},
+		"ResourcePermissionsDataSource": {
+			"database": testAccResourcePermissionsDataSource_database,
+			"table":    testAccResourcePermissionsDataSource_table,
+		},
+		"ResourceLFTags": {
+			"database":         testAccResourceLFTags_database,
+			"disappears":       testAccResourceLFTags_disappears,
+			"table":            testAccResourceLFTags_table,
+			"tableWithColumns": testAccResourceLFTags_tableWithColumns,
+		},
 	}
 
 	for group, m := range testCases {
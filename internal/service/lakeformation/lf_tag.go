@@ -0,0 +1,206 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	lfTagIDSeparator = ":"
+
+	// UpdateLFTag accepts at most 50 values to add and 50 values to delete per call.
+	lfTagValuesUpdateBatchSize = 50
+)
+
+func ResourceLFTag() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLFTagCreate,
+		Read:   resourceLFTagRead,
+		Update: resourceLFTagUpdate,
+		Delete: resourceLFTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"key": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"values": {
+				Type:     schema.TypeSet,
+				MaxItems: 1000,
+				MinItems: 1,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				Set: schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceLFTagCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	var catalogID string
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = v.(string)
+	} else {
+		catalogID = meta.(*conns.AWSClient).AccountID
+	}
+	key := d.Get("key").(string)
+
+	input := &lakeformation.CreateLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+		TagValues: flex.ExpandStringSet(d.Get("values").(*schema.Set)),
+	}
+
+	_, err := conn.CreateLFTag(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Lake Formation LF-Tag (%s): %w", key, err)
+	}
+
+	d.SetId(LFTagCreateID(catalogID, key))
+
+	return resourceLFTagRead(d, meta)
+}
+
+func resourceLFTagRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, key, err := LFTagParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindLFTag(conn, catalogID, key)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation LF-Tag (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	d.Set("catalog_id", output.CatalogId)
+	d.Set("key", output.TagKey)
+	d.Set("values", flex.FlattenStringSet(output.TagValues))
+
+	return nil
+}
+
+func resourceLFTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, key, err := LFTagParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	o, n := d.GetChange("values")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+	add := flex.ExpandStringSet(ns.Difference(os))
+	del := flex.ExpandStringSet(os.Difference(ns))
+
+	// Values are added before they are deleted so that the LF-Tag always has at least one value.
+	for len(add) > 0 || len(del) > 0 {
+		input := &lakeformation.UpdateLFTagInput{
+			CatalogId: aws.String(catalogID),
+			TagKey:    aws.String(key),
+		}
+
+		if n := len(add); n > 0 {
+			if n > lfTagValuesUpdateBatchSize {
+				n = lfTagValuesUpdateBatchSize
+			}
+
+			input.TagValuesToAdd, add = add[:n], add[n:]
+		} else {
+			n := len(del)
+
+			if n > lfTagValuesUpdateBatchSize {
+				n = lfTagValuesUpdateBatchSize
+			}
+
+			input.TagValuesToDelete, del = del[:n], del[n:]
+		}
+
+		_, err := conn.UpdateLFTag(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating Lake Formation LF-Tag (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceLFTagRead(d, meta)
+}
+
+func resourceLFTagDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID, key, err := LFTagParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lake Formation LF-Tag: %s", d.Id())
+	_, err = conn.DeleteLFTag(&lakeformation.DeleteLFTagInput{
+		CatalogId: aws.String(catalogID),
+		TagKey:    aws.String(key),
+	})
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation LF-Tag (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func LFTagCreateID(catalogID, key string) string {
+	return strings.Join([]string{catalogID, key}, lfTagIDSeparator)
+}
+
+func LFTagParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, lfTagIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected CATALOG-ID%[2]sTAG-KEY", id, lfTagIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -634,6 +635,73 @@ func testAccPermissions_twcWildcardSelectPlus(t *testing.T) {
 	})
 }
 
+func testAccPermissions_lfTag(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+	tagName := "aws_lakeformation_lf_tag.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_lfTag(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "lf_tag.0.key", tagName, "key"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0", lakeformation.PermissionAssociate),
+					resource.TestCheckResourceAttr(resourceName, "permissions.1", lakeformation.PermissionDescribe),
+					resource.TestCheckResourceAttr(resourceName, "permissions_with_grant_option.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissions_lfTagPolicy(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsConfig_lfTagPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "principal", roleName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "catalog_resource", "false"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.resource_type", lakeformation.ResourceTypeTable),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag_policy.0.expression.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag_policy.0.expression.*", map[string]string{
+						"key":      rName,
+						"values.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag_policy.0.expression.*", map[string]string{
+						"key":      fmt.Sprintf("%s-2", rName),
+						"values.#": "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions.0", lakeformation.PermissionSelect),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPermissionsDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
 
@@ -736,6 +804,45 @@ func permissionCountForLakeFormationResource(conn *lakeformation.LakeFormation,
 		noResource = false
 	}
 
+	if v, ok := rs.Primary.Attributes["lf_tag.#"]; ok && v != "" && v != "0" {
+		input.Resource.LFTag = &lakeformation.LFTagKeyResource{
+			TagKey:    aws.String(rs.Primary.Attributes["lf_tag.0.key"]),
+			TagValues: aws.StringSlice(testAccStateSetValues(rs, "lf_tag.0.values")),
+		}
+
+		if v := rs.Primary.Attributes["lf_tag.0.catalog_id"]; v != "" {
+			input.Resource.LFTag.CatalogId = aws.String(v)
+		}
+
+		noResource = false
+	}
+
+	if v, ok := rs.Primary.Attributes["lf_tag_policy.#"]; ok && v != "" && v != "0" {
+		input.Resource.LFTagPolicy = &lakeformation.LFTagPolicyResource{
+			ResourceType: aws.String(rs.Primary.Attributes["lf_tag_policy.0.resource_type"]),
+		}
+
+		if v := rs.Primary.Attributes["lf_tag_policy.0.catalog_id"]; v != "" {
+			input.Resource.LFTagPolicy.CatalogId = aws.String(v)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			// lf_tag_policy.0.expression.<index>.key
+			parts := strings.Split(k, ".")
+
+			if len(parts) != 5 || parts[2] != "expression" || parts[4] != "key" {
+				continue
+			}
+
+			input.Resource.LFTagPolicy.Expression = append(input.Resource.LFTagPolicy.Expression, &lakeformation.LFTag{
+				TagKey:    aws.String(v),
+				TagValues: aws.StringSlice(testAccStateSetValues(rs, strings.Join(append(parts[:4], "values"), "."))),
+			})
+		}
+
+		noResource = false
+	}
+
 	tableType := ""
 
 	if v, ok := rs.Primary.Attributes["table.#"]; ok && v != "" && v != "0" {
@@ -2099,3 +2206,78 @@ resource "aws_lakeformation_permissions" "test" {
 }
 `, rName)
 }
+
+func testAccPermissionsConfigLFTags(rName string) string {
+	return acctest.ConfigCompose(testAccLFTagConfigDataLakeAdmin(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_lf_tag" "test2" {
+  key    = "%[1]s-2"
+  values = ["value3", "value4"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
+
+func testAccPermissionsConfig_lfTag(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionsConfigLFTags(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["ASSOCIATE", "DESCRIBE"]
+  principal   = aws_iam_role.test.arn
+
+  lf_tag {
+    key    = aws_lakeformation_lf_tag.test.key
+    values = aws_lakeformation_lf_tag.test.values
+  }
+}
+`)
+}
+
+func testAccPermissionsConfig_lfTagPolicy(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionsConfigLFTags(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["SELECT"]
+  principal   = aws_iam_role.test.arn
+
+  lf_tag_policy {
+    resource_type = "TABLE"
+
+    expression {
+      key    = aws_lakeformation_lf_tag.test.key
+      values = ["value1"]
+    }
+
+    expression {
+      key    = aws_lakeformation_lf_tag.test2.key
+      values = aws_lakeformation_lf_tag.test2.values
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,527 @@
+package lakeformation
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceResourceLFTags() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourceLFTagsCreate,
+		Read:   resourceResourceLFTagsRead,
+		Update: resourceResourceLFTagsUpdate,
+		Delete: resourceResourceLFTagsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"lf_tag": {
+				Type:     schema.TypeSet,
+				MinItems: 1,
+				Required: true,
+				Set:      resourceLFTagsHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 255),
+						},
+					},
+				},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"table_with_columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+					"table_with_columns",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"column_names": {
+							Type:     schema.TypeSet,
+							ForceNew: true,
+							MinItems: 1,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceResourceLFTagsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.AddLFTagsToResourceInput{
+		LFTags:   expandLFTagPairs(d.Get("lf_tag").(*schema.Set).List()),
+		Resource: expandResourceLFTagsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if err := addLFTagsToResource(conn, input); err != nil {
+		return fmt.Errorf("error creating Lake Formation Resource LF-Tags: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(input.Resource.String())))
+
+	return resourceResourceLFTagsRead(d, meta)
+}
+
+func resourceResourceLFTagsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           expandResourceLFTagsResource(d),
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	output, err := FindResourceLFTags(conn, input)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Resource LF-Tags (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+	}
+
+	var apiObjects []*lakeformation.LFTagPair
+
+	switch {
+	case input.Resource.Database != nil:
+		apiObjects = output.LFTagOnDatabase
+	case input.Resource.Table != nil:
+		apiObjects = output.LFTagsOnTable
+	case input.Resource.TableWithColumns != nil:
+		apiObjects = lfTagsOnAllColumns(output.LFTagsOnColumns, input.Resource.TableWithColumns.ColumnNames)
+	}
+
+	if err := d.Set("lf_tag", flattenLFTagPairs(apiObjects)); err != nil {
+		return fmt.Errorf("error setting lf_tag: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourceLFTagsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	o, n := d.GetChange("lf_tag")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	// Assigning an LF-Tag that is already on the resource replaces its value, so only
+	// the LF-Tags whose key is no longer configured have to be removed.
+	keys := make(map[string]struct{})
+
+	for _, tfMapRaw := range ns.List() {
+		keys[tfMapRaw.(map[string]interface{})["key"].(string)] = struct{}{}
+	}
+
+	var remove []interface{}
+
+	for _, tfMapRaw := range os.Difference(ns).List() {
+		if _, ok := keys[tfMapRaw.(map[string]interface{})["key"].(string)]; !ok {
+			remove = append(remove, tfMapRaw)
+		}
+	}
+
+	lfResource := expandResourceLFTagsResource(d)
+
+	var catalogID *string
+	if v, ok := d.GetOk("catalog_id"); ok {
+		catalogID = aws.String(v.(string))
+	}
+
+	if len(remove) > 0 {
+		input := &lakeformation.RemoveLFTagsFromResourceInput{
+			CatalogId: catalogID,
+			LFTags:    expandLFTagPairs(remove),
+			Resource:  lfResource,
+		}
+
+		if err := removeLFTagsFromResource(conn, input); err != nil {
+			return fmt.Errorf("error updating Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+		}
+	}
+
+	if add := ns.Difference(os).List(); len(add) > 0 {
+		input := &lakeformation.AddLFTagsToResourceInput{
+			CatalogId: catalogID,
+			LFTags:    expandLFTagPairs(add),
+			Resource:  lfResource,
+		}
+
+		if err := addLFTagsToResource(conn, input); err != nil {
+			return fmt.Errorf("error updating Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+		}
+	}
+
+	return resourceResourceLFTagsRead(d, meta)
+}
+
+func resourceResourceLFTagsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	input := &lakeformation.RemoveLFTagsFromResourceInput{
+		LFTags:   expandLFTagPairs(d.Get("lf_tag").(*schema.Set).List()),
+		Resource: expandResourceLFTagsResource(d),
+	}
+
+	if v, ok := d.GetOk("catalog_id"); ok {
+		input.CatalogId = aws.String(v.(string))
+	}
+
+	if len(input.LFTags) == 0 {
+		return nil
+	}
+
+	err := removeLFTagsFromResource(conn, input)
+
+	if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lake Formation Resource LF-Tags (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func addLFTagsToResource(conn *lakeformation.LakeFormation, input *lakeformation.AddLFTagsToResourceInput) error {
+	var output *lakeformation.AddLFTagsToResourceOutput
+	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.AddLFTagsToResource(input)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.AddLFTagsToResource(input)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil {
+		return lfTagErrors(output.Failures)
+	}
+
+	return nil
+}
+
+func removeLFTagsFromResource(conn *lakeformation.LakeFormation, input *lakeformation.RemoveLFTagsFromResourceInput) error {
+	var output *lakeformation.RemoveLFTagsFromResourceOutput
+	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		var err error
+		output, err = conn.RemoveLFTagsFromResource(input)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.RemoveLFTagsFromResource(input)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if output != nil {
+		return lfTagErrors(output.Failures)
+	}
+
+	return nil
+}
+
+func lfTagErrors(failures []*lakeformation.LFTagError) error {
+	var errs *multierror.Error
+
+	for _, failure := range failures {
+		if failure == nil || failure.Error == nil {
+			continue
+		}
+
+		if failure.LFTag == nil {
+			errs = multierror.Append(errs, fmt.Errorf("LF-Tag: %s: %s", aws.StringValue(failure.Error.ErrorCode), aws.StringValue(failure.Error.ErrorMessage)))
+			continue
+		}
+
+		errs = multierror.Append(errs, fmt.Errorf("LF-Tag (%s): %s: %s", aws.StringValue(failure.LFTag.TagKey), aws.StringValue(failure.Error.ErrorCode), aws.StringValue(failure.Error.ErrorMessage)))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// lfTagsOnAllColumns returns the LF-Tags that are assigned with the same value to every one of the given columns.
+func lfTagsOnAllColumns(columns []*lakeformation.ColumnLFTag, columnNames []*string) []*lakeformation.LFTagPair {
+	names := make(map[string]struct{})
+
+	for _, name := range columnNames {
+		names[aws.StringValue(name)] = struct{}{}
+	}
+
+	counts := make(map[string]int)
+	apiObjects := make(map[string]*lakeformation.LFTagPair)
+
+	for _, column := range columns {
+		if column == nil {
+			continue
+		}
+
+		if _, ok := names[aws.StringValue(column.Name)]; !ok {
+			continue
+		}
+
+		for _, apiObject := range column.LFTags {
+			if apiObject == nil || len(apiObject.TagValues) == 0 {
+				continue
+			}
+
+			k := aws.StringValue(apiObject.TagKey) + lfTagIDSeparator + aws.StringValue(apiObject.TagValues[0])
+			counts[k]++
+			apiObjects[k] = apiObject
+		}
+	}
+
+	var result []*lakeformation.LFTagPair
+
+	for k, n := range counts {
+		if n == len(names) {
+			result = append(result, apiObjects[k])
+		}
+	}
+
+	return result
+}
+
+func expandResourceLFTagsResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table_with_columns"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.TableWithColumns = expandLakeFormationTableWithColumnsResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandLFTagPairs(tfList []interface{}) []*lakeformation.LFTagPair {
+	var apiObjects []*lakeformation.LFTagPair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &lakeformation.LFTagPair{
+			TagKey:    aws.String(tfMap["key"].(string)),
+			TagValues: aws.StringSlice([]string{tfMap["value"].(string)}),
+		}
+
+		if v, ok := tfMap["catalog_id"].(string); ok && v != "" {
+			apiObject.CatalogId = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLFTagPairs(apiObjects []*lakeformation.LFTagPair) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, value := range apiObject.TagValues {
+			tfList = append(tfList, map[string]interface{}{
+				"catalog_id": aws.StringValue(apiObject.CatalogId),
+				"key":        aws.StringValue(apiObject.TagKey),
+				"value":      aws.StringValue(value),
+			})
+		}
+	}
+
+	return tfList
+}
+
+// resourceLFTagsHash hashes an LF-Tag by its key and value only, as the catalog ID is computed.
+func resourceLFTagsHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return 0
+	}
+
+	if v, ok := m["key"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["value"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	return create.StringHashcode(buf.String())
+}
@@ -0,0 +1,335 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccResourceLFTags_database(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_database(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value1",
+					}),
+				),
+			},
+			{
+				Config: testAccResourceLFTagsConfig_database(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTags_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_database(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tflakeformation.ResourceResourceLFTags(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourceLFTags_table(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   fmt.Sprintf("%s-2", rName),
+						"value": "value3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceLFTags_tableWithColumns(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_lf_tags.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourceLFTagsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceLFTagsConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceLFTagsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "table_with_columns.0.column_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "lf_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lf_tag.*", map[string]string{
+						"key":   rName,
+						"value": "value2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourceLFTagsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_resource_lf_tags" {
+			continue
+		}
+
+		output, err := tflakeformation.FindResourceLFTags(conn, testAccResourceLFTagsInput(rs))
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LFTagOnDatabase) > 0 || len(output.LFTagsOnTable) > 0 || len(output.LFTagsOnColumns) > 0 {
+			return fmt.Errorf("Lake Formation Resource LF-Tags %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckResourceLFTagsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Resource LF-Tags ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+		output, err := tflakeformation.FindResourceLFTags(conn, testAccResourceLFTagsInput(rs))
+
+		if err != nil {
+			return err
+		}
+
+		if len(output.LFTagOnDatabase) == 0 && len(output.LFTagsOnTable) == 0 && len(output.LFTagsOnColumns) == 0 {
+			return fmt.Errorf("Lake Formation Resource LF-Tags %s do not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceLFTagsInput(rs *terraform.ResourceState) *lakeformation.GetResourceLFTagsInput {
+	input := &lakeformation.GetResourceLFTagsInput{
+		Resource:           &lakeformation.Resource{},
+		ShowAssignedLFTags: aws.Bool(true),
+	}
+
+	if v := rs.Primary.Attributes["catalog_id"]; v != "" {
+		input.CatalogId = aws.String(v)
+	}
+
+	if v := rs.Primary.Attributes["database.#"]; v != "" && v != "0" {
+		input.Resource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	}
+
+	if v := rs.Primary.Attributes["table.#"]; v != "" && v != "0" {
+		input.Resource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table.0.name"]),
+		}
+	}
+
+	if v := rs.Primary.Attributes["table_with_columns.#"]; v != "" && v != "0" {
+		input.Resource.TableWithColumns = &lakeformation.TableWithColumnsResource{
+			ColumnNames:  aws.StringSlice(testAccStateSetValues(rs, "table_with_columns.0.column_names")),
+			DatabaseName: aws.String(rs.Primary.Attributes["table_with_columns.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table_with_columns.0.name"]),
+		}
+	}
+
+	return input
+}
+
+// testAccStateSetValues returns the values of a set of strings from the flattened state of a resource.
+func testAccStateSetValues(rs *terraform.ResourceState, key string) []string {
+	var values []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, key+".") && k != key+".#" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func testAccResourceLFTagsConfigTable(rName string) string {
+	return acctest.ConfigCompose(testAccLFTagConfigDataLakeAdmin(), fmt.Sprintf(`
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+
+resource "aws_lakeformation_lf_tag" "test" {
+  key    = %[1]q
+  values = ["value1", "value2"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_lakeformation_lf_tag" "test2" {
+  key    = "%[1]s-2"
+  values = ["value3", "value4"]
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+`, rName))
+}
+
+func testAccResourceLFTagsConfig_database(rName, value string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagsConfigTable(rName), fmt.Sprintf(`
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = %[1]q
+  }
+}
+`, value))
+}
+
+func testAccResourceLFTagsConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagsConfigTable(rName), `
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value1"
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test2.key
+    value = "value3"
+  }
+}
+`)
+}
+
+func testAccResourceLFTagsConfig_tableWithColumns(rName string) string {
+	return acctest.ConfigCompose(testAccResourceLFTagsConfigTable(rName), `
+resource "aws_lakeformation_resource_lf_tags" "test" {
+  table_with_columns {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+    column_names  = ["event", "timestamp"]
+  }
+
+  lf_tag {
+    key   = aws_lakeformation_lf_tag.test.key
+    value = "value2"
+  }
+}
+`)
+}
@@ -0,0 +1,449 @@
+package lakeformation
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// ResourceResourcePermissions manages every grant on a database or table. Grants to principals that
+// are not in the configuration are revoked, except for data lake administrators, whose implicit
+// permissions cannot be revoked.
+func ResourceResourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceResourcePermissionsCreate,
+		Read:   resourceResourcePermissionsRead,
+		Update: resourceResourcePermissionsUpdate,
+		Delete: resourceResourcePermissionsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				ForceNew:     true,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+			"grant": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							MinItems: 1,
+							Required: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Set:      schema.HashString,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(lakeformation.Permission_Values(), false),
+							},
+						},
+						"principal": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validPrincipal,
+						},
+					},
+				},
+			},
+			"table": {
+				Type:     schema.TypeList,
+				ForceNew: true,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							ForceNew:     true,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							ForceNew: true,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceResourcePermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	lfResource := expandResourcePermissionsResource(d)
+	catalogID := d.Get("catalog_id").(string)
+
+	grants, err := expandResourceGrants(d.Get("grant").(*schema.Set).List(), lfResource)
+
+	if err != nil {
+		return err
+	}
+
+	if err := updateResourceGrants(conn, catalogID, lfResource, grants); err != nil {
+		return fmt.Errorf("error creating Lake Formation Resource Permissions: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(lfResource.String())))
+
+	return resourceResourcePermissionsRead(d, meta)
+}
+
+func resourceResourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	grants, err := findManagedResourceGrants(conn, d.Get("catalog_id").(string), expandResourcePermissionsResource(d))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lake Formation Resource Permissions (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource Permissions (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("grant", flattenResourceGrants(grants)); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	return nil
+}
+
+func resourceResourcePermissionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	lfResource := expandResourcePermissionsResource(d)
+
+	grants, err := expandResourceGrants(d.Get("grant").(*schema.Set).List(), lfResource)
+
+	if err != nil {
+		return err
+	}
+
+	if err := updateResourceGrants(conn, d.Get("catalog_id").(string), lfResource, grants); err != nil {
+		return fmt.Errorf("error updating Lake Formation Resource Permissions (%s): %w", d.Id(), err)
+	}
+
+	return resourceResourcePermissionsRead(d, meta)
+}
+
+func resourceResourcePermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	lfResource := expandResourcePermissionsResource(d)
+
+	grants, err := expandResourceGrants(d.Get("grant").(*schema.Set).List(), lfResource)
+
+	if err != nil {
+		return err
+	}
+
+	for _, grant := range grants {
+		err := revokeResourceGrant(conn, d.Get("catalog_id").(string), lfResource, grant)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeEntityNotFoundException) || tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "No permissions revoked") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting Lake Formation Resource Permissions (%s): %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+// findManagedResourceGrants returns the grants on a database or table, excluding those of data lake administrators.
+func findManagedResourceGrants(conn *lakeformation.LakeFormation, catalogID string, lfResource *lakeformation.Resource) ([]*ResourceGrant, error) {
+	admins, err := FindDataLakeAdmins(conn, catalogID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading data lake administrators: %w", err)
+	}
+
+	permissions, err := FindPermissionsByResource(conn, catalogID, lfResource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var grants []*ResourceGrant
+
+	for _, grant := range GrantsForResource(lfResource, permissions) {
+		if !stringInSlice(grant.Principal, admins) {
+			grants = append(grants, grant)
+		}
+	}
+
+	return grants, nil
+}
+
+// updateResourceGrants revokes and grants permissions so that the principals other than data lake
+// administrators hold exactly the given grants on a database or table.
+func updateResourceGrants(conn *lakeformation.LakeFormation, catalogID string, lfResource *lakeformation.Resource, grants []*ResourceGrant) error {
+	old, err := findManagedResourceGrants(conn, catalogID, lfResource)
+
+	if err != nil {
+		return err
+	}
+
+	revoke, grant := DiffGrants(old, grants)
+
+	for _, v := range revoke {
+		log.Printf("[DEBUG] Revoking Lake Formation permissions %v (grant option %v) from %s", v.Permissions, v.PermissionsWithGrantOption, v.Principal)
+		if err := revokeResourceGrant(conn, catalogID, lfResource, v); err != nil {
+			return fmt.Errorf("revoking permissions from %s: %w", v.Principal, err)
+		}
+	}
+
+	for _, v := range grant {
+		log.Printf("[DEBUG] Granting Lake Formation permissions %v (grant option %v) to %s", v.Permissions, v.PermissionsWithGrantOption, v.Principal)
+		if err := grantResourceGrant(conn, catalogID, lfResource, v); err != nil {
+			return fmt.Errorf("granting permissions to %s: %w", v.Principal, err)
+		}
+	}
+
+	return nil
+}
+
+func grantResourceGrant(conn *lakeformation.LakeFormation, catalogID string, lfResource *lakeformation.Resource, grant *ResourceGrant) error {
+	input := &lakeformation.GrantPermissionsInput{
+		Permissions:                aws.StringSlice(grant.Permissions),
+		PermissionsWithGrantOption: aws.StringSlice(grant.PermissionsWithGrantOption),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(grant.Principal),
+		},
+		Resource: expandGrantResource(lfResource, grant),
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.GrantPermissions(input)
+
+		if tfawserr.ErrMessageContains(err, lakeformation.ErrCodeInvalidInputException, "Invalid principal") {
+			return resource.RetryableError(err)
+		}
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.GrantPermissions(input)
+	}
+
+	return err
+}
+
+func revokeResourceGrant(conn *lakeformation.LakeFormation, catalogID string, lfResource *lakeformation.Resource, grant *ResourceGrant) error {
+	input := &lakeformation.RevokePermissionsInput{
+		Permissions:                aws.StringSlice(grant.Permissions),
+		PermissionsWithGrantOption: aws.StringSlice(grant.PermissionsWithGrantOption),
+		Principal: &lakeformation.DataLakePrincipal{
+			DataLakePrincipalIdentifier: aws.String(grant.Principal),
+		},
+		Resource: expandGrantResource(lfResource, grant),
+	}
+
+	if catalogID != "" {
+		input.CatalogId = aws.String(catalogID)
+	}
+
+	err := resource.Retry(permissionsDeleteRetryTimeout, func() *resource.RetryError {
+		_, err := conn.RevokePermissions(input)
+
+		if tfawserr.ErrCodeEquals(err, lakeformation.ErrCodeConcurrentModificationException) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.RevokePermissions(input)
+	}
+
+	return err
+}
+
+func expandResourcePermissionsResource(d *schema.ResourceData) *lakeformation.Resource {
+	apiObject := &lakeformation.Resource{}
+
+	if v, ok := d.GetOk("database"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Database = ExpandDatabaseResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("table"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiObject.Table = ExpandTableResource(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandResourceGrants(tfList []interface{}, lfResource *lakeformation.Resource) ([]*ResourceGrant, error) {
+	var grants []*ResourceGrant
+	keys := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		grant := &ResourceGrant{
+			Principal: tfMap["principal"].(string),
+		}
+
+		if v, ok := tfMap["column_names"].(*schema.Set); ok && v.Len() > 0 {
+			grant.ColumnNames = sortedStrings(flex.ExpandStringSet(v))
+		}
+
+		if v, ok := tfMap["excluded_column_names"].(*schema.Set); ok && v.Len() > 0 {
+			grant.ExcludedColumnNames = sortedStrings(flex.ExpandStringSet(v))
+		}
+
+		if v, ok := tfMap["permissions"].(*schema.Set); ok && v.Len() > 0 {
+			grant.Permissions = sortedStrings(flex.ExpandStringSet(v))
+		}
+
+		if v, ok := tfMap["permissions_with_grant_option"].(*schema.Set); ok && v.Len() > 0 {
+			grant.PermissionsWithGrantOption = sortedStrings(flex.ExpandStringSet(v))
+		}
+
+		if len(grant.ColumnNames) > 0 && len(grant.ExcludedColumnNames) > 0 {
+			return nil, fmt.Errorf("grant for %s: only one of column_names or excluded_column_names can be set", grant.Principal)
+		}
+
+		if lfResource.Table == nil && (len(grant.ColumnNames) > 0 || len(grant.ExcludedColumnNames) > 0) {
+			return nil, fmt.Errorf("grant for %s: column_names and excluded_column_names can only be set for a table", grant.Principal)
+		}
+
+		if _, ok := keys[grant.key()]; ok {
+			return nil, fmt.Errorf("grant for %s: a principal can only have one grant for the same columns", grant.Principal)
+		}
+
+		keys[grant.key()] = struct{}{}
+		grants = append(grants, grant)
+	}
+
+	return grants, nil
+}
+
+func flattenResourceGrants(grants []*ResourceGrant) []interface{} {
+	var tfList []interface{}
+
+	for _, grant := range grants {
+		tfList = append(tfList, map[string]interface{}{
+			"column_names":                  flex.FlattenStringSet(aws.StringSlice(grant.ColumnNames)),
+			"excluded_column_names":         flex.FlattenStringSet(aws.StringSlice(grant.ExcludedColumnNames)),
+			"permissions":                   flex.FlattenStringSet(aws.StringSlice(grant.Permissions)),
+			"permissions_with_grant_option": flex.FlattenStringSet(aws.StringSlice(grant.PermissionsWithGrantOption)),
+			"principal":                     grant.Principal,
+		})
+	}
+
+	return tfList
+}
+
+func stringInSlice(v string, s []string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package lakeformation
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceResourcePermissions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceResourcePermissionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalog_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"database": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"grant": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"data_lake_admin": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"excluded_column_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"permissions": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"permissions_with_grant_option": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"iam_allowed_principals": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"table": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ExactlyOneOf: []string{
+					"database",
+					"table",
+				},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"catalog_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"database_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceResourcePermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).LakeFormationConn
+
+	catalogID := d.Get("catalog_id").(string)
+	lfResource := expandResourcePermissionsResource(d)
+
+	admins, err := FindDataLakeAdmins(conn, catalogID)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation data lake administrators: %w", err)
+	}
+
+	permissions, err := FindPermissionsByResource(conn, catalogID, lfResource)
+
+	if err != nil {
+		return fmt.Errorf("error reading Lake Formation Resource Permissions: %w", err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", create.StringHashcode(lfResource.String())))
+
+	iamAllowedPrincipals := false
+	var tfList []interface{}
+
+	for _, grant := range GrantsForResource(lfResource, permissions) {
+		if grant.Principal == IAMAllowedPrincipals {
+			iamAllowedPrincipals = true
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"column_names":                  flex.FlattenStringSet(aws.StringSlice(grant.ColumnNames)),
+			"data_lake_admin":               stringInSlice(grant.Principal, admins),
+			"excluded_column_names":         flex.FlattenStringSet(aws.StringSlice(grant.ExcludedColumnNames)),
+			"permissions":                   flex.FlattenStringSet(aws.StringSlice(grant.Permissions)),
+			"permissions_with_grant_option": flex.FlattenStringSet(aws.StringSlice(grant.PermissionsWithGrantOption)),
+			"principal":                     grant.Principal,
+		})
+	}
+
+	if err := d.Set("grant", tfList); err != nil {
+		return fmt.Errorf("error setting grant: %w", err)
+	}
+
+	d.Set("iam_allowed_principals", iamAllowedPrincipals)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package lakeformation_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccResourcePermissionsDataSource_database(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lakeformation_resource_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsDataSourceConfig_database(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "database.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "grant.*.principal", roleName, "arn"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "grant.*", map[string]string{
+						"data_lake_admin":                 "false",
+						"permissions.#":                   "3",
+						"permissions_with_grant_option.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePermissionsDataSource_table(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsDataSourceConfig_table(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "table.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "grant.*", map[string]string{
+						"column_names.#":  "2",
+						"column_names.0":  "event",
+						"column_names.1":  "timestamp",
+						"data_lake_admin": "false",
+						"permissions.#":   "1",
+						"permissions.0":   lakeformation.PermissionSelect,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePermissionsDataSourceConfig_database(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfigBase(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  permissions                   = ["ALTER", "CREATE_TABLE", "DROP"]
+  permissions_with_grant_option = ["CREATE_TABLE"]
+  principal                     = aws_iam_role.test.arn
+
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+}
+
+data "aws_lakeformation_resource_permissions" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  depends_on = [aws_lakeformation_permissions.test]
+}
+`)
+}
+
+func testAccResourcePermissionsDataSourceConfig_table(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfigBase(rName), `
+resource "aws_lakeformation_permissions" "test" {
+  permissions = ["SELECT"]
+  principal   = aws_iam_role.test.arn
+
+  table_with_columns {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+    column_names  = ["event", "timestamp"]
+  }
+}
+
+data "aws_lakeformation_resource_permissions" "test" {
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  depends_on = [aws_lakeformation_permissions.test]
+}
+`)
+}
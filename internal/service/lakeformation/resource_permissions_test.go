@@ -0,0 +1,368 @@
+package lakeformation_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lakeformation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflakeformation "github.com/hashicorp/terraform-provider-aws/internal/service/lakeformation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func testAccResourcePermissions_database(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+	roleName := "aws_iam_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsConfig_database(rName, `"ALTER", "DROP"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePermissionsGrantCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "database.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "database.0.name", "aws_glue_catalog_database.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant.*.principal", roleName, "arn"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionAlter),
+					resource.TestCheckTypeSetElemAttr(resourceName, "grant.*.permissions.*", lakeformation.PermissionDrop),
+				),
+			},
+			{
+				Config: testAccResourcePermissionsConfig_database(rName, `"CREATE_TABLE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePermissionsGrantCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"permissions.#": "1",
+						"permissions.0": lakeformation.PermissionCreateTable,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePermissions_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsConfig_database(rName, `"ALTER"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePermissionsGrantCount(resourceName, 1),
+					acctest.CheckResourceDisappears(acctest.Provider, tflakeformation.ResourceResourcePermissions(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccResourcePermissions_revokeUnmanaged(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsConfig_database(rName, `"ALTER"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePermissionsGrantCount(resourceName, 1),
+					testAccCheckResourcePermissionsGrantOutOfBand(resourceName, tflakeformation.IAMAllowedPrincipals, lakeformation.PermissionDescribe),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccResourcePermissionsConfig_database(rName, `"ALTER"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePermissionsGrantCount(resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePermissions_tableWithColumns(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lakeformation_resource_permissions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(lakeformation.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, lakeformation.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckResourcePermissionsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePermissionsConfig_tableWithColumns(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourcePermissionsGrantCount(resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "table.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "table.0.name", "aws_glue_catalog_table.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "grant.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"column_names.#":          "2",
+						"excluded_column_names.#": "0",
+						"permissions.#":           "1",
+						"permissions.0":           lakeformation.PermissionSelect,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "grant.*", map[string]string{
+						"column_names.#":          "0",
+						"excluded_column_names.#": "0",
+						"permissions.#":           "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcePermissionsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lakeformation_resource_permissions" {
+			continue
+		}
+
+		var principals []string
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "grant.") && strings.HasSuffix(k, ".principal") {
+				principals = append(principals, v)
+			}
+		}
+
+		grants, err := testAccResourcePermissionsGrants(conn, rs)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		for _, grant := range grants {
+			for _, principal := range principals {
+				if grant.Principal == principal {
+					return fmt.Errorf("Lake Formation Resource Permissions %s still exist for %s", rs.Primary.ID, principal)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// testAccCheckResourcePermissionsGrantCount checks the number of grants on the resource, excluding those of data lake administrators.
+func testAccCheckResourcePermissionsGrantCount(resourceName string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lake Formation Resource Permissions ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+		grants, err := testAccResourcePermissionsGrants(conn, rs)
+
+		if err != nil {
+			return err
+		}
+
+		admins, err := tflakeformation.FindDataLakeAdmins(conn, rs.Primary.Attributes["catalog_id"])
+
+		if err != nil {
+			return err
+		}
+
+		count := 0
+
+	grants:
+		for _, grant := range grants {
+			for _, admin := range admins {
+				if grant.Principal == admin {
+					continue grants
+				}
+			}
+
+			count++
+		}
+
+		if count != expected {
+			return fmt.Errorf("Lake Formation Resource Permissions %s: got %d grants, expected %d", rs.Primary.ID, count, expected)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckResourcePermissionsGrantOutOfBand grants permissions on the resource outside of Terraform.
+func testAccCheckResourcePermissionsGrantOutOfBand(resourceName, principal string, permissions ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LakeFormationConn
+
+		_, err := conn.GrantPermissions(&lakeformation.GrantPermissionsInput{
+			Permissions: aws.StringSlice(permissions),
+			Principal: &lakeformation.DataLakePrincipal{
+				DataLakePrincipalIdentifier: aws.String(principal),
+			},
+			Resource: testAccResourcePermissionsResource(rs),
+		})
+
+		return err
+	}
+}
+
+func testAccResourcePermissionsGrants(conn *lakeformation.LakeFormation, rs *terraform.ResourceState) ([]*tflakeformation.ResourceGrant, error) {
+	lfResource := testAccResourcePermissionsResource(rs)
+
+	permissions, err := tflakeformation.FindPermissionsByResource(conn, rs.Primary.Attributes["catalog_id"], lfResource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tflakeformation.GrantsForResource(lfResource, permissions), nil
+}
+
+func testAccResourcePermissionsResource(rs *terraform.ResourceState) *lakeformation.Resource {
+	lfResource := &lakeformation.Resource{}
+
+	if v := rs.Primary.Attributes["database.#"]; v != "" && v != "0" {
+		lfResource.Database = &lakeformation.DatabaseResource{
+			Name: aws.String(rs.Primary.Attributes["database.0.name"]),
+		}
+	}
+
+	if v := rs.Primary.Attributes["table.#"]; v != "" && v != "0" {
+		lfResource.Table = &lakeformation.TableResource{
+			DatabaseName: aws.String(rs.Primary.Attributes["table.0.database_name"]),
+			Name:         aws.String(rs.Primary.Attributes["table.0.name"]),
+		}
+	}
+
+	return lfResource
+}
+
+func testAccResourcePermissionsConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccLFTagConfigDataLakeAdmin(), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+  path = "/"
+
+  assume_role_policy = jsonencode({
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "glue.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+    Version = "2012-10-17"
+  })
+}
+
+resource "aws_glue_catalog_database" "test" {
+  name = %[1]q
+
+  # for consistency, ensure that admins are setup before testing
+  depends_on = [aws_lakeformation_data_lake_settings.test]
+}
+
+resource "aws_glue_catalog_table" "test" {
+  name          = %[1]q
+  database_name = aws_glue_catalog_database.test.name
+
+  storage_descriptor {
+    columns {
+      name = "event"
+      type = "string"
+    }
+
+    columns {
+      name = "timestamp"
+      type = "date"
+    }
+
+    columns {
+      name = "value"
+      type = "double"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccResourcePermissionsConfig_database(rName, permissions string) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfigBase(rName), fmt.Sprintf(`
+resource "aws_lakeformation_resource_permissions" "test" {
+  database {
+    name = aws_glue_catalog_database.test.name
+  }
+
+  grant {
+    permissions = [%[1]s]
+    principal   = aws_iam_role.test.arn
+  }
+}
+`, permissions))
+}
+
+func testAccResourcePermissionsConfig_tableWithColumns(rName string) string {
+	return acctest.ConfigCompose(testAccResourcePermissionsConfigBase(rName), `
+resource "aws_lakeformation_resource_permissions" "test" {
+  table {
+    database_name = aws_glue_catalog_table.test.database_name
+    name          = aws_glue_catalog_table.test.name
+  }
+
+  grant {
+    column_names = ["event", "timestamp"]
+    permissions  = ["SELECT"]
+    principal    = aws_iam_role.test.arn
+  }
+
+  grant {
+    permissions = ["ALTER", "DESCRIBE"]
+    principal   = aws_iam_role.test.arn
+  }
+}
+`)
+}
@@ -1,6 +1,7 @@
 package organizations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,8 +9,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,14 +23,33 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	accountCreateTimeout = 10 * time.Minute
+	accountDeleteTimeout = 10 * time.Minute
+	accountMoveTimeout   = 2 * time.Minute
+	accountUpdateTimeout = 10 * time.Minute
+
+	// accountDefaultRoleName is the role that Organizations creates in a new account when role_name is not set.
+	accountDefaultRoleName       = "OrganizationAccountAccessRole"
+	accountVerifyRoleSessionName = "terraform-provider-aws"
+
+	errCodeAccessDenied = "AccessDenied"
+)
+
 func ResourceAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAccountCreate,
-		Read:   resourceAccountRead,
-		Update: resourceAccountUpdate,
-		Delete: resourceAccountDelete,
+		CreateContext: resourceAccountCreate,
+		Read:          resourceAccountRead,
+		UpdateContext: resourceAccountUpdate,
+		Delete:        resourceAccountDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceAccountImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(accountCreateTimeout),
+			Update: schema.DefaultTimeout(accountUpdateTimeout),
+			Delete: schema.DefaultTimeout(accountDeleteTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,13 +109,20 @@ func ResourceAccount() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			"verify_role_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
 	}
 }
 
-func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -125,13 +155,13 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	)
 
 	if err != nil {
-		return fmt.Errorf("error creating AWS Organizations Account (%s): %w", name, err)
+		return diag.Errorf("error creating AWS Organizations Account (%s): %s", name, err)
 	}
 
-	output, err := waitAccountCreated(conn, aws.StringValue(outputRaw.(*organizations.CreateAccountOutput).CreateAccountStatus.Id))
+	output, err := waitAccountCreated(conn, aws.StringValue(outputRaw.(*organizations.CreateAccountOutput).CreateAccountStatus.Id), d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("error waiting for AWS Organizations Account (%s) create: %w", name, err)
+		return diag.Errorf("error waiting for AWS Organizations Account (%s) create: %s", name, err)
 	}
 
 	d.SetId(aws.StringValue(output.AccountId))
@@ -140,24 +170,27 @@ func resourceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 		oldParentAccountID, err := findParentAccountID(conn, d.Id())
 
 		if err != nil {
-			return fmt.Errorf("error reading AWS Organizations Account (%s) parent: %w", d.Id(), err)
+			return diag.Errorf("error reading AWS Organizations Account (%s) parent: %s", d.Id(), err)
 		}
 
 		if newParentAccountID := v.(string); newParentAccountID != oldParentAccountID {
-			input := &organizations.MoveAccountInput{
-				AccountId:           aws.String(d.Id()),
-				DestinationParentId: aws.String(newParentAccountID),
-				SourceParentId:      aws.String(oldParentAccountID),
+			if err := moveAccount(conn, d.Id(), oldParentAccountID, newParentAccountID); err != nil {
+				return diag.Errorf("error moving AWS Organizations Account (%s): %s", d.Id(), err)
 			}
+		}
+	}
 
-			log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
-			if _, err := conn.MoveAccount(input); err != nil {
-				return fmt.Errorf("error moving AWS Organizations Account (%s): %w", d.Id(), err)
-			}
+	// The account exists at this point, so a failed verification is reported as a warning instead of an error,
+	// which would taint the account and replace it on the next apply.
+	// Recording the verification as not done makes the next apply retry it.
+	if d.Get("verify_role_access").(bool) {
+		if err := resourceAccountVerifyRoleAccess(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+			d.Set("verify_role_access", false)
+			diags = append(diags, resourceAccountVerifyRoleAccessWarning(d.Id(), err))
 		}
 	}
 
-	return resourceAccountRead(d, meta)
+	return append(diags, diag.FromErr(resourceAccountRead(d, meta))...)
 }
 
 func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
@@ -211,20 +244,16 @@ func resourceAccountRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
 	if d.HasChange("parent_id") {
 		o, n := d.GetChange("parent_id")
 
-		input := &organizations.MoveAccountInput{
-			AccountId:           aws.String(d.Id()),
-			SourceParentId:      aws.String(o.(string)),
-			DestinationParentId: aws.String(n.(string)),
-		}
-
-		if _, err := conn.MoveAccount(input); err != nil {
-			return fmt.Errorf("error moving AWS Organizations Account (%s): %w", d.Id(), err)
+		if err := moveAccount(conn, d.Id(), o.(string), n.(string)); err != nil {
+			return diag.Errorf("error moving AWS Organizations Account (%s): %s", d.Id(), err)
 		}
 	}
 
@@ -232,31 +261,51 @@ func resourceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating AWS Organizations Account (%s) tags: %w", d.Id(), err)
+			return diag.Errorf("error updating AWS Organizations Account (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("verify_role_access") && d.Get("verify_role_access").(bool) {
+		if err := resourceAccountVerifyRoleAccess(d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			d.Set("verify_role_access", false)
+			diags = append(diags, resourceAccountVerifyRoleAccessWarning(d.Id(), err))
 		}
 	}
 
-	return resourceAccountRead(d, meta)
+	return append(diags, diag.FromErr(resourceAccountRead(d, meta))...)
 }
 
 func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
-	close := d.Get("close_on_deletion").(bool)
-	var err error
-
-	if close {
+	if d.Get("close_on_deletion").(bool) {
 		log.Printf("[DEBUG] Closing AWS Organizations Account: %s", d.Id())
-		_, err = conn.CloseAccount(&organizations.CloseAccountInput{
-			AccountId: aws.String(d.Id()),
-		})
-	} else {
-		log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
-		_, err = conn.RemoveAccountFromOrganization(&organizations.RemoveAccountFromOrganizationInput{
-			AccountId: aws.String(d.Id()),
-		})
+		err := closeAccount(conn, d.Id(), d.Timeout(schema.TimeoutDelete))
+
+		if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
+			return nil
+		}
+
+		if isCloseAccountQuotaExceededError(err) {
+			return fmt.Errorf("error closing AWS Organizations Account (%s), the organization has closed as many accounts as it may in a 30 day period; retry later or set close_on_deletion to false to remove the account from the organization instead: %w", d.Id(), err)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error closing AWS Organizations Account (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitAccountDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("error waiting for AWS Organizations Account (%s) delete: %w", d.Id(), err)
+		}
+
+		return nil
 	}
 
+	log.Printf("[DEBUG] Removing AWS Organizations Account from organization: %s", d.Id())
+	_, err := conn.RemoveAccountFromOrganization(&organizations.RemoveAccountFromOrganizationInput{
+		AccountId: aws.String(d.Id()),
+	})
+
 	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountNotFoundException) {
 		return nil
 	}
@@ -265,10 +314,140 @@ func resourceAccountDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error deleting AWS Organizations Account (%s): %w", d.Id(), err)
 	}
 
-	if close {
-		if _, err := waitAccountDeleted(conn, d.Id()); err != nil {
-			return fmt.Errorf("error waiting for AWS Organizations Account (%s) delete: %w", d.Id(), err)
-		}
+	return nil
+}
+
+// closeAccount closes an account, waiting for earlier close requests to finish when
+// the organization is closing as many accounts at once as it may.
+func closeAccount(conn *organizations.Organizations, id string, timeout time.Duration) error {
+	input := &organizations.CloseAccountInput{
+		AccountId: aws.String(id),
+	}
+
+	_, err := tfresource.RetryWhen(timeout,
+		func() (interface{}, error) {
+			return conn.CloseAccount(input)
+		},
+		func(err error) (bool, error) {
+			if isCloseAccountRequestsLimitExceededError(err) {
+				return true, err
+			}
+
+			if tfawserr.ErrCodeEquals(err, organizations.ErrCodeConcurrentModificationException, organizations.ErrCodeTooManyRequestsException) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeAccountAlreadyClosedException) {
+		return nil
+	}
+
+	return err
+}
+
+func isCloseAccountQuotaExceededError(err error) bool {
+	var e *organizations.ConstraintViolationException
+
+	return errors.As(err, &e) && aws.StringValue(e.Reason) == organizations.ConstraintViolationExceptionReasonCloseAccountQuotaExceeded
+}
+
+func isCloseAccountRequestsLimitExceededError(err error) bool {
+	var e *organizations.ConstraintViolationException
+
+	return errors.As(err, &e) && aws.StringValue(e.Reason) == organizations.ConstraintViolationExceptionReasonCloseAccountRequestsLimitExceeded
+}
+
+func moveAccount(conn *organizations.Organizations, id, sourceParentID, destinationParentID string) error {
+	input := &organizations.MoveAccountInput{
+		AccountId:           aws.String(id),
+		DestinationParentId: aws.String(destinationParentID),
+		SourceParentId:      aws.String(sourceParentID),
+	}
+
+	log.Printf("[DEBUG] Moving AWS Organizations Account: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(accountMoveTimeout,
+		func() (interface{}, error) {
+			return conn.MoveAccount(input)
+		},
+		organizations.ErrCodeConcurrentModificationException,
+		organizations.ErrCodeTooManyRequestsException,
+	)
+
+	return err
+}
+
+func resourceAccountImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("verify_role_access", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAccountVerifyRoleAccessWarning(id string, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Unable to verify role access to AWS Organizations Account (%s)", id),
+		Detail:   fmt.Sprintf("verify_role_access has been recorded as false, so the next apply retries the verification: %s", err),
+	}
+}
+
+// resourceAccountVerifyRoleAccess assumes the configured role, or the default role, in the account.
+func resourceAccountVerifyRoleAccess(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	roleName := accountDefaultRoleName
+
+	if v, ok := d.GetOk("role_name"); ok {
+		roleName = v.(string)
+	}
+
+	if err := verifyAccountRoleAccess(meta.(*conns.AWSClient).STSConn, meta.(*conns.AWSClient).Partition, d.Id(), roleName, timeout); err != nil {
+		return fmt.Errorf("error verifying access to AWS Organizations Account (%s) with role (%s): %w", d.Id(), roleName, err)
+	}
+
+	return nil
+}
+
+// verifyAccountRoleAccess assumes the role that was created in a new account, retrying while the role propagates.
+func verifyAccountRoleAccess(conn *sts.STS, partition, id, roleName string, timeout time.Duration) error {
+	roleARN := arn.ARN{
+		Partition: partition,
+		Service:   "iam",
+		AccountID: id,
+		Resource:  "role/" + roleName,
+	}.String()
+	input := &sts.AssumeRoleInput{
+		DurationSeconds: aws.Int64(900),
+		RoleArn:         aws.String(roleARN),
+		RoleSessionName: aws.String(accountVerifyRoleSessionName),
+	}
+
+	log.Printf("[DEBUG] Assuming IAM Role: %s", input)
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(timeout,
+		func() (interface{}, error) {
+			return conn.AssumeRole(input)
+		},
+		errCodeAccessDenied,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	output := outputRaw.(*sts.AssumeRoleOutput)
+
+	if output == nil || output.AssumedRoleUser == nil {
+		return fmt.Errorf("assuming IAM Role (%s): empty result", roleARN)
+	}
+
+	v, err := arn.Parse(aws.StringValue(output.AssumedRoleUser.Arn))
+
+	if err != nil {
+		return err
+	}
+
+	if v.AccountID != id {
+		return fmt.Errorf("assumed IAM Role (%s) belongs to AWS Account (%s)", roleARN, v.AccountID)
 	}
 
 	return nil
@@ -344,13 +523,13 @@ func statusCreateAccountState(conn *organizations.Organizations, id string) reso
 	}
 }
 
-func waitAccountCreated(conn *organizations.Organizations, id string) (*organizations.CreateAccountStatus, error) {
+func waitAccountCreated(conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.CreateAccountStatus, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{organizations.CreateAccountStateInProgress},
 		Target:       []string{organizations.CreateAccountStateSucceeded},
 		Refresh:      statusCreateAccountState(conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -382,13 +561,13 @@ func statusAccountStatus(conn *organizations.Organizations, id string) resource.
 	}
 }
 
-func waitAccountDeleted(conn *organizations.Organizations, id string) (*organizations.Account, error) {
+func waitAccountDeleted(conn *organizations.Organizations, id string, timeout time.Duration) (*organizations.Account, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{organizations.AccountStatusPendingClosure},
 		Target:       []string{},
 		Refresh:      statusAccountStatus(conn, id),
 		PollInterval: 10 * time.Second,
-		Timeout:      5 * time.Minute,
+		Timeout:      timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForState()
@@ -200,6 +200,44 @@ func testAccAccount_Tags(t *testing.T) {
 	})
 }
 
+func testAccAccount_VerifyRoleAccess(t *testing.T) {
+	key := "TEST_AWS_ORGANIZATION_ACCOUNT_EMAIL_DOMAIN"
+	orgsEmailDomain := os.Getenv(key)
+	if orgsEmailDomain == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	var v organizations.Account
+	resourceName := "aws_organizations_account.test"
+	rInt := sdkacctest.RandInt()
+	name := fmt.Sprintf("tf_acctest_%d", rInt)
+	email := fmt.Sprintf("tf-acctest+%d@%s", rInt, orgsEmailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsEnabled(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountVerifyRoleAccessConfig(name, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "role_name", "tf-acctest-access"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "verify_role_access", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"close_on_deletion", "role_name", "verify_role_access"},
+			},
+		},
+	})
+}
+
 func testAccCheckAccountDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).OrganizationsConn
 
@@ -269,6 +307,18 @@ resource "aws_organizations_account" "test" {
 `, name, email)
 }
 
+func testAccAccountVerifyRoleAccessConfig(name, email string) string {
+	return fmt.Sprintf(`
+resource "aws_organizations_account" "test" {
+  name               = %[1]q
+  email              = %[2]q
+  role_name          = "tf-acctest-access"
+  verify_role_access = true
+  close_on_deletion  = true
+}
+`, name, email)
+}
+
 func testAccAccountParentId1Config(name, email string) string {
 	return fmt.Sprintf(`
 resource "aws_organizations_organization" "test" {}
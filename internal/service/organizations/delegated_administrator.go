@@ -10,9 +10,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -86,6 +88,10 @@ func resourceDelegatedAdministratorCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(fmt.Sprintf("%s/%s", accountID, servicePrincipal))
 
+	if _, err := waitDelegatedAdministratorRegistered(ctx, conn, accountID, servicePrincipal); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Organizations DelegatedAdministrator (%s) register: %w", d.Id(), err))
+	}
+
 	return resourceDelegatedAdministratorRead(ctx, d, meta)
 }
 
@@ -96,29 +102,19 @@ func resourceDelegatedAdministratorRead(ctx context.Context, d *schema.ResourceD
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error decoding ID AWS Organization (%s) DelegatedAdministrators: %w", d.Id(), err))
 	}
-	input := &organizations.ListDelegatedAdministratorsInput{
-		ServicePrincipal: aws.String(servicePrincipal),
-	}
-	var delegatedAccount *organizations.DelegatedAdministrator
-	err = conn.ListDelegatedAdministratorsPagesWithContext(ctx, input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
-		for _, delegated := range page.DelegatedAdministrators {
-			if aws.StringValue(delegated.Id) == accountID {
-				delegatedAccount = delegated
-			}
-		}
 
-		return !lastPage
-	})
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error listing AWS Organization (%s) DelegatedAdministrators: %w", d.Id(), err))
-	}
+	delegatedAccount, err := FindDelegatedAdministratorByTwoPartKey(ctx, conn, accountID, servicePrincipal)
 
-	if delegatedAccount == nil {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] AWS Organization DelegatedAdministrators not found (%s), removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing AWS Organization (%s) DelegatedAdministrators: %w", d.Id(), err))
+	}
+
 	d.Set("arn", delegatedAccount.Arn)
 	d.Set("delegation_enabled_date", aws.TimeValue(delegatedAccount.DelegationEnabledDate).Format(time.RFC3339))
 	d.Set("email", delegatedAccount.Email)
@@ -148,6 +144,11 @@ func resourceDelegatedAdministratorDelete(ctx context.Context, d *schema.Resourc
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error deleting Organizations DelegatedAdministrator (%s): %w", d.Id(), err))
 	}
+
+	if err := waitDelegatedAdministratorDeregistered(ctx, conn, accountID, servicePrincipal); err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for Organizations DelegatedAdministrator (%s) deregister: %w", d.Id(), err))
+	}
+
 	return nil
 }
 
@@ -158,3 +159,90 @@ func DecodeOrganizationDelegatedAdministratorID(id string) (string, string, erro
 	}
 	return idParts[0], idParts[1], nil
 }
+
+func FindDelegatedAdministratorByTwoPartKey(ctx context.Context, conn *organizations.Organizations, accountID, servicePrincipal string) (*organizations.DelegatedAdministrator, error) {
+	input := &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: aws.String(servicePrincipal),
+	}
+	var result *organizations.DelegatedAdministrator
+
+	err := conn.ListDelegatedAdministratorsPagesWithContext(ctx, input, func(page *organizations.ListDelegatedAdministratorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, delegated := range page.DelegatedAdministrators {
+			if aws.StringValue(delegated.Id) == accountID {
+				result = delegated
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
+
+const (
+	// Organizations only reports the status of the delegated account itself, not of its registration.
+	delegatedAdministratorStatusRegistered = "Registered"
+
+	delegatedAdministratorRegisteredTimeout   = 2 * time.Minute
+	delegatedAdministratorDeregisteredTimeout = 2 * time.Minute
+)
+
+func statusDelegatedAdministrator(ctx context.Context, conn *organizations.Organizations, accountID, servicePrincipal string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDelegatedAdministratorByTwoPartKey(ctx, conn, accountID, servicePrincipal)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, delegatedAdministratorStatusRegistered, nil
+	}
+}
+
+func waitDelegatedAdministratorRegistered(ctx context.Context, conn *organizations.Organizations, accountID, servicePrincipal string) (*organizations.DelegatedAdministrator, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{},
+		Target:                    []string{delegatedAdministratorStatusRegistered},
+		Refresh:                   statusDelegatedAdministrator(ctx, conn, accountID, servicePrincipal),
+		Timeout:                   delegatedAdministratorRegisteredTimeout,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*organizations.DelegatedAdministrator); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDelegatedAdministratorDeregistered(ctx context.Context, conn *organizations.Organizations, accountID, servicePrincipal string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{delegatedAdministratorStatusRegistered},
+		Target:  []string{},
+		Refresh: statusDelegatedAdministrator(ctx, conn, accountID, servicePrincipal),
+		Timeout: delegatedAdministratorDeregisteredTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
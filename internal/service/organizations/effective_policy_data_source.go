@@ -0,0 +1,68 @@
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEffectivePolicyRead,
+		Schema: map[string]*schema.Schema{
+			"last_updated_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policy_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(organizations.EffectivePolicyType_Values(), false),
+			},
+			"target_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+		},
+	}
+}
+
+func dataSourceEffectivePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).OrganizationsConn
+
+	policyType := d.Get("policy_type").(string)
+	policy, err := FindEffectivePolicy(conn, policyType, d.Get("target_id").(string))
+
+	if err != nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError(fmt.Sprintf("Organizations Effective Policy (%s)", policyType), err))
+	}
+
+	targetID := aws.StringValue(policy.TargetId)
+
+	d.SetId(fmt.Sprintf("%s,%s", targetID, policyType))
+	if policy.LastUpdatedTimestamp != nil {
+		d.Set("last_updated_timestamp", aws.TimeValue(policy.LastUpdatedTimestamp).Format(time.RFC3339))
+	} else {
+		d.Set("last_updated_timestamp", nil)
+	}
+	d.Set("policy_content", policy.PolicyContent)
+	d.Set("policy_type", policy.PolicyType)
+	d.Set("target_id", targetID)
+
+	return nil
+}
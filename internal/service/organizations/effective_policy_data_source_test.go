@@ -0,0 +1,71 @@
+package organizations_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccEffectivePolicyDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_organizations_effective_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck: acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEffectivePolicyDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrRFC3339(dataSourceName, "last_updated_timestamp"),
+					resource.TestMatchResourceAttr(dataSourceName, "policy_content", regexp.MustCompile(`"Product"`)),
+					resource.TestCheckResourceAttr(dataSourceName, "policy_type", organizations.EffectivePolicyTypeTagPolicy),
+					resource.TestCheckResourceAttrPair(dataSourceName, "target_id", "data.aws_caller_identity.current", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEffectivePolicyDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  enabled_policy_types = ["TAG_POLICY"]
+}
+
+resource "aws_organizations_policy" "test" {
+  content = jsonencode({
+    tags = {
+      Product = {
+        tag_key = {
+          "@@assign" = "Product"
+        }
+      }
+    }
+  })
+
+  name = %[1]q
+  type = "TAG_POLICY"
+
+  depends_on = [aws_organizations_organization.test]
+}
+
+resource "aws_organizations_policy_attachment" "test" {
+  policy_id = aws_organizations_policy.test.id
+  target_id = data.aws_caller_identity.current.account_id
+}
+
+data "aws_organizations_effective_policy" "test" {
+  policy_type = "TAG_POLICY"
+  target_id   = aws_organizations_policy_attachment.test.target_id
+}
+`, rName)
+}
@@ -62,3 +62,32 @@ func FindOrganization(conn *organizations.Organizations) (*organizations.Organiz
 
 	return output.Organization, nil
 }
+
+func FindEffectivePolicy(conn *organizations.Organizations, policyType, targetID string) (*organizations.EffectivePolicy, error) {
+	input := &organizations.DescribeEffectivePolicyInput{
+		PolicyType: aws.String(policyType),
+	}
+
+	if targetID != "" {
+		input.TargetId = aws.String(targetID)
+	}
+
+	output, err := conn.DescribeEffectivePolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, organizations.ErrCodeEffectivePolicyNotFoundException, organizations.ErrCodeTargetNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EffectivePolicy == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.EffectivePolicy, nil
+}
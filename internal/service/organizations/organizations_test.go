@@ -16,10 +16,11 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"DataSource":                 testAccOrganizationDataSource_basic,
 		},
 		"Account": {
-			"basic":           testAccAccount_basic,
-			"CloseOnDeletion": testAccAccount_CloseOnDeletion,
-			"ParentId":        testAccAccount_ParentID,
-			"Tags":            testAccAccount_Tags,
+			"basic":            testAccAccount_basic,
+			"CloseOnDeletion":  testAccAccount_CloseOnDeletion,
+			"ParentId":         testAccAccount_ParentID,
+			"Tags":             testAccAccount_Tags,
+			"VerifyRoleAccess": testAccAccount_VerifyRoleAccess,
 		},
 		"OrganizationalUnit": {
 			"basic":      testAccOrganizationalUnit_basic,
@@ -41,6 +42,7 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"Type_SCP":               testAccPolicy_type_SCP,
 			"Type_Tag":               testAccPolicy_type_Tag,
 			"ImportAwsManagedPolicy": testAccPolicy_ImportAwsManagedPolicy,
+			"InvalidContent":         testAccPolicy_invalidContent,
 		},
 		"PolicyAttachment": {
 			"Account":            testAccPolicyAttachment_Account,
@@ -51,6 +53,9 @@ func TestAccOrganizations_serial(t *testing.T) {
 			"basic":      testAccDelegatedAdministrator_basic,
 			"disappears": testAccDelegatedAdministrator_disappears,
 		},
+		"EffectivePolicy": {
+			"DataSource": testAccEffectivePolicyDataSource_basic,
+		},
 		"ResourceTags": {
 			"basic": testAccResourceTagsDataSource_basic,
 		},
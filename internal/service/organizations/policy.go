@@ -9,7 +9,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -59,7 +61,10 @@ func ResourcePolicy() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourcePolicyCustomizeDiff,
+		),
 	}
 }
 
@@ -223,6 +228,26 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+// resourcePolicyCustomizeDiff validates the policy content for the policy type, so that invalid content fails at plan time.
+func resourcePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("content") || !diff.NewValueKnown("type") {
+		return nil
+	}
+
+	// Existing policies that were accepted by AWS are only validated when their content changes.
+	if diff.Id() != "" && !diff.HasChange("content") && !diff.HasChange("type") {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, err := range validatePolicyContent(diff.Get("type").(string), diff.Get("content").(string)) {
+		errs = multierror.Append(errs, fmt.Errorf("content is not a valid %s: %w", diff.Get("type").(string), err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	conn := meta.(*conns.AWSClient).OrganizationsConn
 
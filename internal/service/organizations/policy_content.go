@@ -0,0 +1,350 @@
+package organizations
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// Management policy inheritance operators.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_inheritance_mgmt.html.
+const (
+	policyOperatorAppend                       = "@@append"
+	policyOperatorAssign                       = "@@assign"
+	policyOperatorRemove                       = "@@remove"
+	policyOperatorOperatorsAllowedForChild     = "@@operators_allowed_for_child_policies"
+	policyOperatorOperatorsAllowedForChildAll  = "@@all"
+	policyOperatorOperatorsAllowedForChildNone = "@@none"
+)
+
+const (
+	policyStatementEffectAllow = "Allow"
+	policyStatementEffectDeny  = "Deny"
+	policyVersion              = "2012-10-17"
+)
+
+// policyContentMaxSizes are the maximum number of characters in the content of each policy type.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html.
+var policyContentMaxSizes = map[string]int{
+	organizations.PolicyTypeAiservicesOptOutPolicy: 2500,
+	organizations.PolicyTypeBackupPolicy:           10000,
+	organizations.PolicyTypeServiceControlPolicy:   5120,
+	organizations.PolicyTypeTagPolicy:              10000,
+}
+
+var policyActionRegexp = regexp.MustCompile(`^(\*|[a-zA-Z0-9-]+:[a-zA-Z0-9*?]+)$`)
+
+// validatePolicyContent performs an offline validation of the content of an Organizations policy of the given type.
+// Service control policies are checked against the policy grammar that SCPs support.
+// Tag, backup and AI services opt-out policies are checked for their top-level element and their inheritance operators.
+func validatePolicyContent(policyType, content string) []error {
+	var errs []error
+
+	if maxSize, ok := policyContentMaxSizes[policyType]; ok {
+		if n := utf8.RuneCountInString(content); n > maxSize {
+			errs = append(errs, fmt.Errorf("content is %d characters, which exceeds the %s limit of %d characters", n, policyType, maxSize))
+		}
+	}
+
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(content), &v); err != nil {
+		return append(errs, fmt.Errorf("invalid JSON: %w", err))
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return append(errs, fmt.Errorf("content must be a JSON object"))
+	}
+
+	switch policyType {
+	case organizations.PolicyTypeServiceControlPolicy:
+		errs = append(errs, validateServiceControlPolicy(m)...)
+	case organizations.PolicyTypeTagPolicy:
+		errs = append(errs, validateManagementPolicy(m, "tags", []string{policyOperatorAppend, policyOperatorAssign, policyOperatorRemove})...)
+		errs = append(errs, validateTagPolicy(m)...)
+	case organizations.PolicyTypeBackupPolicy:
+		errs = append(errs, validateManagementPolicy(m, "plans", []string{policyOperatorAppend, policyOperatorAssign, policyOperatorRemove})...)
+	case organizations.PolicyTypeAiservicesOptOutPolicy:
+		errs = append(errs, validateManagementPolicy(m, "services", []string{policyOperatorAssign})...)
+	}
+
+	return errs
+}
+
+func validateServiceControlPolicy(m map[string]interface{}) []error {
+	var errs []error
+
+	for _, k := range sortedPolicyKeys(m) {
+		switch k {
+		case "Statement", "Version":
+		default:
+			errs = append(errs, fmt.Errorf("%s: element is not supported in service control policies", k))
+		}
+	}
+
+	if v, ok := m["Version"]; ok && v != policyVersion {
+		errs = append(errs, fmt.Errorf("Version: must be %q", policyVersion))
+	}
+
+	var statements []interface{}
+
+	switch v := m["Statement"].(type) {
+	case map[string]interface{}:
+		statements = []interface{}{v}
+	case []interface{}:
+		statements = v
+	}
+
+	if len(statements) == 0 {
+		return append(errs, fmt.Errorf("Statement: at least one statement is required"))
+	}
+
+	sids := make(map[string]struct{})
+
+	for i, v := range statements {
+		path := fmt.Sprintf("Statement[%d]", i)
+		statement, ok := v.(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: statement must be a JSON object", path))
+			continue
+		}
+
+		for _, k := range sortedPolicyKeys(statement) {
+			switch k {
+			case "Action", "Condition", "Effect", "NotAction", "NotResource", "Resource", "Sid":
+			default:
+				errs = append(errs, fmt.Errorf("%s.%s: element is not supported in service control policies", path, k))
+			}
+		}
+
+		if v, ok := statement["Sid"]; ok {
+			sid, ok := v.(string)
+
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s.Sid: must be a string", path))
+			} else if _, ok := sids[sid]; ok {
+				errs = append(errs, fmt.Errorf("%s.Sid: %q is not unique", path, sid))
+			} else {
+				sids[sid] = struct{}{}
+			}
+		}
+
+		if effect := statement["Effect"]; effect != policyStatementEffectAllow && effect != policyStatementEffectDeny {
+			errs = append(errs, fmt.Errorf("%s.Effect: must be %q or %q", path, policyStatementEffectAllow, policyStatementEffectDeny))
+		}
+
+		errs = append(errs, validatePolicyStatementExactlyOne(path, statement, "Action", "NotAction")...)
+
+		for _, k := range []string{"Action", "NotAction"} {
+			if v, ok := statement[k]; ok {
+				for _, action := range policyStringOrStrings(v) {
+					if !policyActionRegexp.MatchString(action) {
+						errs = append(errs, fmt.Errorf("%s.%s: %q is not a valid action, expected \"*\" or \"service:action\"", path, k, action))
+					}
+				}
+
+				if len(policyStringOrStrings(v)) == 0 {
+					errs = append(errs, fmt.Errorf("%s.%s: must be a string or a non-empty list of strings", path, k))
+				}
+			}
+		}
+
+		if _, ok := statement["Resource"]; ok {
+			if _, ok := statement["NotResource"]; ok {
+				errs = append(errs, fmt.Errorf("%s: only one of Resource or NotResource can be set", path))
+			}
+		}
+
+		if v, ok := statement["Condition"]; ok {
+			if _, ok := v.(map[string]interface{}); !ok {
+				errs = append(errs, fmt.Errorf("%s.Condition: must be a JSON object", path))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validatePolicyStatementExactlyOne(path string, statement map[string]interface{}, keys ...string) []error {
+	n := 0
+
+	for _, k := range keys {
+		if _, ok := statement[k]; ok {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return []error{fmt.Errorf("%s: exactly one of %s is required", path, strings.Join(keys, " or "))}
+	}
+
+	return nil
+}
+
+// validateManagementPolicy checks that a management policy only has the expected top-level element and
+// that it only uses the given value-setting operators and valid child control operators.
+func validateManagementPolicy(m map[string]interface{}, root string, operators []string) []error {
+	var errs []error
+
+	for _, k := range sortedPolicyKeys(m) {
+		if k != root {
+			errs = append(errs, fmt.Errorf("%s: element is not supported, expected %q", k, root))
+		}
+	}
+
+	if _, ok := m[root].(map[string]interface{}); !ok {
+		errs = append(errs, fmt.Errorf("%s: must be a JSON object", root))
+	}
+
+	return append(errs, validatePolicyOperators("", m, operators)...)
+}
+
+func validatePolicyOperators(path string, v interface{}, operators []string) []error {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+
+	for _, k := range sortedPolicyKeys(m) {
+		keyPath := policyPath(path, k)
+
+		if !strings.HasPrefix(k, "@@") {
+			errs = append(errs, validatePolicyOperators(keyPath, m[k], operators)...)
+			continue
+		}
+
+		if k == policyOperatorOperatorsAllowedForChild {
+			allowed := append([]string{policyOperatorOperatorsAllowedForChildAll, policyOperatorOperatorsAllowedForChildNone}, operators...)
+
+			for _, operator := range policyStringOrStrings(m[k]) {
+				if !policyStringInSlice(operator, allowed) {
+					errs = append(errs, fmt.Errorf("%s: %q is not a valid child control operator, expected one of %s", keyPath, operator, strings.Join(allowed, ", ")))
+				}
+			}
+
+			continue
+		}
+
+		if !policyStringInSlice(k, operators) {
+			errs = append(errs, fmt.Errorf("%s: operator is not supported, expected one of %s", keyPath, strings.Join(operators, ", ")))
+			continue
+		}
+
+		if _, ok := m[k].(map[string]interface{}); ok {
+			errs = append(errs, fmt.Errorf("%s: value must be a string or a list of strings", keyPath))
+		}
+	}
+
+	return errs
+}
+
+// validateTagPolicy checks the elements of each tag in a tag policy.
+func validateTagPolicy(m map[string]interface{}) []error {
+	tags, ok := m["tags"].(map[string]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	var errs []error
+
+	for _, name := range sortedPolicyKeys(tags) {
+		path := policyPath("tags", name)
+		tag, ok := tags[name].(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: must be a JSON object", path))
+			continue
+		}
+
+		for _, k := range sortedPolicyKeys(tag) {
+			switch k {
+			case "enforced_for", "report_required", "tag_value", policyOperatorOperatorsAllowedForChild:
+			case "tag_key":
+				tagKey, ok := tag[k].(map[string]interface{})
+
+				if !ok {
+					errs = append(errs, fmt.Errorf("%s.tag_key: must be a JSON object", path))
+					continue
+				}
+
+				for _, operator := range sortedPolicyKeys(tagKey) {
+					if operator != policyOperatorAssign && operator != policyOperatorOperatorsAllowedForChild {
+						errs = append(errs, fmt.Errorf("%s.tag_key.%s: only %s is supported for tag keys", path, operator, policyOperatorAssign))
+					}
+				}
+
+				if v, ok := tagKey[policyOperatorAssign]; ok {
+					if _, ok := v.(string); !ok {
+						errs = append(errs, fmt.Errorf("%s.tag_key.%s: must be a string", path, policyOperatorAssign))
+					}
+				}
+			default:
+				errs = append(errs, fmt.Errorf("%s.%s: element is not supported in tag policies", path, k))
+			}
+		}
+	}
+
+	return errs
+}
+
+func policyPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
+
+// policyStringOrStrings returns the strings in a value that is either a string or a list of strings.
+func policyStringOrStrings(v interface{}) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var s []string
+
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+
+		return s
+	}
+
+	return nil
+}
+
+func policyStringInSlice(v string, s []string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedPolicyKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
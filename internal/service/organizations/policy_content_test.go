@@ -0,0 +1,176 @@
+package organizations
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+func TestValidatePolicyContent(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		PolicyType   string
+		Content      string
+		ExpectErrors []*regexp.Regexp
+	}{
+		{
+			Name:       "service control policy",
+			PolicyType: organizations.PolicyTypeServiceControlPolicy,
+			//lintignore:AWSAT003
+			Content: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "DenyLeaveOrganization",
+      "Effect": "Deny",
+      "Action": "organizations:LeaveOrganization",
+      "Resource": "*"
+    },
+    {
+      "Sid": "DenyOutsideRegions",
+      "Effect": "Deny",
+      "NotAction": ["iam:*", "sts:*"],
+      "Resource": "*",
+      "Condition": {"StringNotEquals": {"aws:RequestedRegion": ["eu-west-1"]}}
+    }
+  ]
+}`,
+		},
+		{
+			Name:       "service control policy single statement",
+			PolicyType: organizations.PolicyTypeServiceControlPolicy,
+			Content:    `{"Statement": {"Effect": "Allow", "Action": "*", "Resource": "*"}}`,
+		},
+		{
+			Name:         "invalid json",
+			PolicyType:   organizations.PolicyTypeServiceControlPolicy,
+			Content:      `{`,
+			ExpectErrors: []*regexp.Regexp{regexp.MustCompile(`^invalid JSON`)},
+		},
+		{
+			Name:       "service control policy too large",
+			PolicyType: organizations.PolicyTypeServiceControlPolicy,
+			Content:    `{"Statement": {"Sid": "` + strings.Repeat("a", 5120) + `", "Effect": "Deny", "Action": "*", "Resource": "*"}}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^content is 5196 characters, which exceeds the SERVICE_CONTROL_POLICY limit of 5120 characters$`),
+			},
+		},
+		{
+			Name:       "service control policy grammar",
+			PolicyType: organizations.PolicyTypeServiceControlPolicy,
+			Content: `{
+  "Version": "2008-10-17",
+  "Id": "example",
+  "Statement": [
+    {"Sid": "A", "Effect": "deny", "Action": "s3", "Principal": "*", "Resource": "*", "NotResource": "*"},
+    {"Sid": "A", "Effect": "Allow", "Action": "*", "NotAction": "s3:*", "Condition": "yes"},
+    {"Effect": "Allow", "Action": []}
+  ]
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^Id: element is not supported in service control policies$`),
+				regexp.MustCompile(`^Version: must be "2012-10-17"$`),
+				regexp.MustCompile(`^Statement\[0\].Principal: element is not supported in service control policies$`),
+				regexp.MustCompile(`^Statement\[0\].Effect: must be "Allow" or "Deny"$`),
+				regexp.MustCompile(`^Statement\[0\].Action: "s3" is not a valid action`),
+				regexp.MustCompile(`^Statement\[0\]: only one of Resource or NotResource can be set$`),
+				regexp.MustCompile(`^Statement\[1\].Sid: "A" is not unique$`),
+				regexp.MustCompile(`^Statement\[1\]: exactly one of Action or NotAction is required$`),
+				regexp.MustCompile(`^Statement\[1\].Condition: must be a JSON object$`),
+				regexp.MustCompile(`^Statement\[2\].Action: must be a string or a non-empty list of strings$`),
+			},
+		},
+		{
+			Name:         "service control policy no statements",
+			PolicyType:   organizations.PolicyTypeServiceControlPolicy,
+			Content:      `{"Version": "2012-10-17", "Statement": []}`,
+			ExpectErrors: []*regexp.Regexp{regexp.MustCompile(`^Statement: at least one statement is required$`)},
+		},
+		{
+			Name:       "tag policy",
+			PolicyType: organizations.PolicyTypeTagPolicy,
+			Content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@assign": "CostCenter", "@@operators_allowed_for_child_policies": ["@@none"]},
+      "tag_value": {"@@assign": ["100", "200"]},
+      "enforced_for": {"@@assign": ["secretsmanager:*"]}
+    }
+  }
+}`,
+		},
+		{
+			Name:       "tag policy operators",
+			PolicyType: organizations.PolicyTypeTagPolicy,
+			Content: `{
+  "tags": {
+    "costcenter": {
+      "tag_key": {"@@append": "CostCenter"},
+      "tag_value": {"@@replace": ["100"], "@@operators_allowed_for_child_policies": ["@@everything"]},
+      "owner": {"@@assign": "Team"}
+    }
+  },
+  "plans": {}
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^plans: element is not supported, expected "tags"$`),
+				regexp.MustCompile(`^tags.costcenter.tag_value.@@operators_allowed_for_child_policies: "@@everything" is not a valid child control operator`),
+				regexp.MustCompile(`^tags.costcenter.tag_value.@@replace: operator is not supported`),
+				regexp.MustCompile(`^tags.costcenter.owner: element is not supported in tag policies$`),
+				regexp.MustCompile(`^tags.costcenter.tag_key.@@append: only @@assign is supported for tag keys$`),
+			},
+		},
+		{
+			Name:       "backup policy",
+			PolicyType: organizations.PolicyTypeBackupPolicy,
+			//lintignore:AWSAT003
+			Content: `{
+  "plans": {
+    "daily": {
+      "regions": {"@@append": ["us-east-1"]},
+      "rules": {"daily": {"schedule_expression": {"@@assign": "cron(0 5 ? * * *)"}}}
+    }
+  }
+}`,
+		},
+		{
+			Name:       "backup policy operator value",
+			PolicyType: organizations.PolicyTypeBackupPolicy,
+			Content:    `{"plans": {"daily": {"rules": {"@@assign": {"daily": {}}}}}}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^plans.daily.rules.@@assign: value must be a string or a list of strings$`),
+			},
+		},
+		{
+			Name:       "ai services opt-out policy",
+			PolicyType: organizations.PolicyTypeAiservicesOptOutPolicy,
+			Content:    `{"services": {"default": {"opt_out_policy": {"@@assign": "optOut"}}}}`,
+		},
+		{
+			Name:       "ai services opt-out policy operators",
+			PolicyType: organizations.PolicyTypeAiservicesOptOutPolicy,
+			Content:    `{"services": {"default": {"opt_out_policy": {"@@append": "optOut"}}}}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^services.default.opt_out_policy.@@append: operator is not supported, expected one of @@assign$`),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			errs := validatePolicyContent(testCase.PolicyType, testCase.Content)
+
+			if len(errs) != len(testCase.ExpectErrors) {
+				t.Fatalf("expected %d errors, got %d: %v", len(testCase.ExpectErrors), len(errs), errs)
+			}
+
+			for i, err := range errs {
+				if !testCase.ExpectErrors[i].MatchString(err.Error()) {
+					t.Errorf("expected error %d to match %q, got %q", i, testCase.ExpectErrors[i], err)
+				}
+			}
+		})
+	}
+}
@@ -385,6 +385,27 @@ func testAccPolicy_type_Tag(t *testing.T) {
 	})
 }
 
+func testAccPolicy_invalidContent(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckOrganizationsAccount(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, organizations.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_Required(rName, `{"Version": "2012-10-17", "Statement": {"Effect": "Deny", "Principal": "*", "Resource": "*"}}`),
+				ExpectError: regexp.MustCompile(`content is not a valid SERVICE_CONTROL_POLICY: Statement\[0\]: exactly one of Action or NotAction is required`),
+			},
+			{
+				Config:      testAccPolicyConfig_Type(rName, `{"tags": {"Product": {"tag_key": {"@@append": "Product"}}}}`, organizations.PolicyTypeTagPolicy),
+				ExpectError: regexp.MustCompile(`content is not a valid TAG_POLICY: tags.Product.tag_key.@@append: only @@assign is supported for tag keys`),
+			},
+		},
+	})
+}
+
 func testAccPolicy_ImportAwsManagedPolicy(t *testing.T) {
 	resourceName := "aws_organizations_policy.test"
 
@@ -0,0 +1,295 @@
+package rds
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func blueGreenUpdateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"delete_source": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func blueGreenUpdateEnabled(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("blue_green_update"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)
+	}
+
+	return false
+}
+
+func blueGreenUpdateDeleteSource(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("blue_green_update"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return v.([]interface{})[0].(map[string]interface{})["delete_source"].(bool)
+	}
+
+	return false
+}
+
+// blueGreenSwitchover creates a blue/green deployment, waits for the green environment to be
+// provisioned and in sync with the blue environment, and switches over to it.
+// If the deployment cannot be switched over, it is deleted along with the green environment.
+// On success, the returned deployment's switchover details identify the old blue resources.
+func blueGreenSwitchover(conn *rds.RDS, input *rds.CreateBlueGreenDeploymentInput, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment: %s", input)
+	output, err := conn.CreateBlueGreenDeployment(input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS Blue/Green Deployment: %w", err)
+	}
+
+	id := aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+	deadline := time.Now().Add(timeout)
+
+	if _, err := waitBlueGreenDeploymentAvailable(conn, id, time.Until(deadline)); err != nil {
+		return nil, blueGreenRollback(conn, id, fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) to be available: %w", id, err))
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment: %s", id)
+	_, err = conn.SwitchoverBlueGreenDeployment(&rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, blueGreenRollback(conn, id, fmt.Errorf("error switching over RDS Blue/Green Deployment (%s): %w", id, err))
+	}
+
+	deployment, err := waitBlueGreenDeploymentSwitchoverCompleted(conn, id, time.Until(deadline))
+
+	if err != nil {
+		// A failed switchover is rolled back by RDS, leaving the blue environment in service.
+		if deployment != nil && aws.StringValue(deployment.Status) == BlueGreenDeploymentStatusSwitchoverFailed {
+			return nil, blueGreenRollback(conn, id, fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) switchover: %w", id, err))
+		}
+
+		return nil, fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) switchover: %w", id, err)
+	}
+
+	// The deployment must be deleted before the old blue resources can be.
+	if err := deleteBlueGreenDeployment(conn, id, false, time.Until(deadline)); err != nil {
+		return nil, err
+	}
+
+	return deployment, nil
+}
+
+// blueGreenRollback deletes a blue/green deployment that was not switched over, along with its green environment.
+func blueGreenRollback(conn *rds.RDS, id string, err error) error {
+	if deleteErr := deleteBlueGreenDeployment(conn, id, true, blueGreenDeploymentDeletedTimeout); deleteErr != nil {
+		log.Printf("[WARN] %s", deleteErr)
+	}
+
+	return err
+}
+
+func deleteBlueGreenDeployment(conn *rds.RDS, id string, deleteTarget bool, timeout time.Duration) error {
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", id)
+	_, err := conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+		DeleteTarget:                  aws.Bool(deleteTarget),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS Blue/Green Deployment (%s): %w", id, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+// blueGreenSourceMembers returns the identifiers of the old blue DB clusters and DB instances
+// of a switched over blue/green deployment.
+func blueGreenSourceMembers(deployment *rds.BlueGreenDeployment) (clusterIDs []string, instanceIDs []string, err error) {
+	for _, v := range deployment.SwitchoverDetails {
+		sourceARN, err := arn.Parse(aws.StringValue(v.SourceMember))
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch res := sourceARN.Resource; {
+		case strings.HasPrefix(res, "cluster:"):
+			clusterIDs = append(clusterIDs, strings.TrimPrefix(res, "cluster:"))
+		case strings.HasPrefix(res, "db:"):
+			instanceIDs = append(instanceIDs, strings.TrimPrefix(res, "db:"))
+		}
+	}
+
+	return clusterIDs, instanceIDs, nil
+}
+
+// blueGreenDeleteSourceCheck returns an error if the old blue resources of a blue/green
+// deployment are to be deleted but cannot be, so that the deployment is never started.
+// Deletion protection is never lifted from the old blue resources.
+func blueGreenDeleteSourceCheck(d *schema.ResourceData) error {
+	if !blueGreenUpdateDeleteSource(d) {
+		return nil
+	}
+
+	if o, _ := d.GetChange("deletion_protection"); o.(bool) {
+		return fmt.Errorf("blue_green_update delete_source cannot be used while deletion_protection is enabled")
+	}
+
+	if _, _, err := blueGreenSourceFinalSnapshotIdentifier(d, ""); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// blueGreenSourceFinalSnapshotIdentifier returns the final snapshot settings used when deleting
+// an old blue DB instance or DB cluster. The resource's final_snapshot_identifier is suffixed with
+// the old blue identifier so that it remains available for the resource's own final snapshot.
+func blueGreenSourceFinalSnapshotIdentifier(d *schema.ResourceData, sourceID string) (bool, string, error) {
+	if d.Get("skip_final_snapshot").(bool) {
+		return true, "", nil
+	}
+
+	v, ok := d.GetOk("final_snapshot_identifier")
+
+	if !ok {
+		return false, "", fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
+	}
+
+	return false, fmt.Sprintf("%s-%s", v.(string), sourceID), nil
+}
+
+// blueGreenDeleteSourceInstance deletes an old blue DB instance.
+// A final DB snapshot is taken unless skipFinalSnapshot is true.
+func blueGreenDeleteSourceInstance(conn *rds.RDS, id string, skipFinalSnapshot bool, finalSnapshotID string, timeout time.Duration) error {
+	instance, err := FindDBInstanceByID(conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Instance (%s): %w", id, err)
+	}
+
+	if aws.BoolValue(instance.DeletionProtection) {
+		return fmt.Errorf("RDS DB Instance (%s) has deletion protection enabled", id)
+	}
+
+	input := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier: aws.String(id),
+		SkipFinalSnapshot:    aws.Bool(skipFinalSnapshot),
+	}
+
+	if !skipFinalSnapshot {
+		input.FinalDBSnapshotIdentifier = aws.String(finalSnapshotID)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS DB Instance: %s", id)
+	_, err = conn.DeleteDBInstance(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS DB Instance (%s): %w", id, err)
+	}
+
+	if aws.StringValue(instance.DBClusterIdentifier) != "" {
+		_, err = waitDBClusterInstanceDeleted(conn, id, timeout)
+	} else {
+		_, err = waitDBInstanceDeleted(conn, id, timeout)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error waiting for RDS DB Instance (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+// blueGreenDeleteSourceCluster deletes an old blue DB cluster.
+// A final DB cluster snapshot is taken unless skipFinalSnapshot is true.
+// The cluster's DB instances must already have been deleted.
+func blueGreenDeleteSourceCluster(conn *rds.RDS, id string, skipFinalSnapshot bool, finalSnapshotID string, timeout time.Duration) error {
+	cluster, err := FindDBClusterByID(conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster (%s): %w", id, err)
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		return fmt.Errorf("RDS Cluster (%s) has deletion protection enabled", id)
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(id),
+		SkipFinalSnapshot:   aws.Bool(skipFinalSnapshot),
+	}
+
+	if !skipFinalSnapshot {
+		input.FinalDBSnapshotIdentifier = aws.String(finalSnapshotID)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Cluster: %s", id)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.DeleteDBCluster(input)
+
+		if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting RDS Cluster (%s): %w", id, err)
+	}
+
+	if err := WaitForClusterDeletion(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Cluster (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
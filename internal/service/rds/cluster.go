@@ -84,6 +84,7 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": blueGreenUpdateSchema(),
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -1140,6 +1141,16 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RDSConn
 	requestUpdate := false
 
+	// Engine version and parameter group changes are applied to a green environment
+	// which is then switched over to, instead of modifying the cluster in place.
+	blueGreenUpdate := d.HasChanges("db_cluster_parameter_group_name", "db_instance_parameter_group_name", "engine_version") && blueGreenUpdateEnabled(d)
+
+	if blueGreenUpdate {
+		if err := resourceClusterBlueGreenUpdate(d, conn); err != nil {
+			return err
+		}
+	}
+
 	req := &rds.ModifyDBClusterInput{
 		ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 		DBClusterIdentifier: aws.String(d.Id()),
@@ -1159,7 +1170,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
-	if d.HasChange("db_instance_parameter_group_name") {
+	if d.HasChange("db_instance_parameter_group_name") && !blueGreenUpdate {
 		req.DBInstanceParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
 		requestUpdate = true
 	}
@@ -1174,7 +1185,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
-	if d.HasChange("engine_version") {
+	if d.HasChange("engine_version") && !blueGreenUpdate {
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
 		requestUpdate = true
 	}
@@ -1223,7 +1234,7 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
-	if d.HasChange("db_cluster_parameter_group_name") {
+	if d.HasChange("db_cluster_parameter_group_name") && !blueGreenUpdate {
 		req.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		requestUpdate = true
 	}
@@ -1443,6 +1454,72 @@ func resourceClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceClusterBlueGreenUpdate(d *schema.ResourceData, conn *rds.RDS) error {
+	if err := blueGreenDeleteSourceCheck(d); err != nil {
+		return fmt.Errorf("error updating RDS Cluster (%s) using blue/green deployment: %w", d.Id(), err)
+	}
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(resource.UniqueId()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+
+	if d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("db_instance_parameter_group_name").(string))
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	deployment, err := blueGreenSwitchover(conn, input, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error updating RDS Cluster (%s) using blue/green deployment: %w", d.Id(), err)
+	}
+
+	clusterIDs, instanceIDs, err := blueGreenSourceMembers(deployment)
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS Cluster (%s) blue/green deployment source: %w", d.Id(), err)
+	}
+
+	// The old cluster and its instances are kept unless their deletion has been opted into.
+	if !blueGreenUpdateDeleteSource(d) {
+		for _, id := range clusterIDs {
+			log.Printf("[INFO] Keeping RDS Cluster (%s) blue/green deployment source: %s", d.Id(), id)
+		}
+
+		return nil
+	}
+
+	// The old cluster's instances must be deleted before the cluster itself.
+	// Cluster instances have no snapshots of their own; the final snapshot is taken of the cluster.
+	for _, id := range instanceIDs {
+		if err := blueGreenDeleteSourceInstance(conn, id, true, "", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error deleting RDS Cluster (%s) blue/green deployment source: %w", d.Id(), err)
+		}
+	}
+
+	for _, id := range clusterIDs {
+		skipFinalSnapshot, finalSnapshotID, err := blueGreenSourceFinalSnapshotIdentifier(d, id)
+
+		if err != nil {
+			return fmt.Errorf("error deleting RDS Cluster (%s) blue/green deployment source: %w", d.Id(), err)
+		}
+
+		if err := blueGreenDeleteSourceCluster(conn, id, skipFinalSnapshot, finalSnapshotID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error deleting RDS Cluster (%s) blue/green deployment source: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
 func resourceClusterStateRefreshFunc(conn *rds.RDS, dbClusterIdentifier string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := conn.DescribeDBClusters(&rds.DescribeDBClustersInput{
@@ -140,6 +140,48 @@ func TestAccRDSCluster_allowMajorVersionUpgrade(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbCluster1, dbCluster2 rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+	// If these hardcoded versions become a maintenance burden, use DescribeDBEngineVersions.
+	engine := "aurora-postgresql"
+	engineVersion1 := "12.9"
+	engineVersion2 := "13.5"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_EngineVersion(rName, engine, engineVersion1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.delete_source", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", engineVersion1),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_EngineVersion(rName, engine, engineVersion2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(resourceName, &dbCluster2),
+					testAccCheckClusterRecreated(&dbCluster1, &dbCluster2),
+					resource.TestCheckResourceAttr(resourceName, "cluster_identifier", rName),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", engineVersion2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_allowMajorVersionUpgradeWithCustomParametersApplyImm(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -2277,6 +2319,66 @@ resource "aws_rds_cluster_instance" "test" {
 `, allowMajorVersionUpgrade, rName, engine, engineVersion)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_EngineVersion(rName string, engine string, engineVersion string) string {
+	return fmt.Sprintf(`
+locals {
+  major_version = split(".", %[3]q)[0]
+}
+
+# The green cluster's parameter group must match its major engine version.
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = "%[1]s-${local.major_version}"
+  family = "%[2]s${local.major_version}"
+
+  # Blue/green deployments replicate using logical replication.
+  parameter {
+    name         = "rds.logical_replication"
+    value        = "1"
+    apply_method = "pending-reboot"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  allow_major_version_upgrade     = true
+  apply_immediately               = true
+  cluster_identifier              = %[1]q
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = %[2]q
+  engine_version                  = %[3]q
+  master_password                 = "mustbeeightcharaters"
+  master_username                 = "test"
+  skip_final_snapshot             = true
+
+  blue_green_update {
+    delete_source = true
+    enabled       = true
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = aws_rds_cluster.test.engine
+  engine_version             = aws_rds_cluster.test.engine_version
+  preferred_instance_classes = ["db.t3.medium", "db.r5.large", "db.r4.large"]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  cluster_identifier = aws_rds_cluster.test.id
+  engine             = data.aws_rds_orderable_db_instance.test.engine
+  engine_version     = data.aws_rds_orderable_db_instance.test.engine_version
+  identifier         = %[1]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+
+  lifecycle {
+    ignore_changes = [engine_version]
+  }
+}
+`, rName, engine, engineVersion)
+}
+
 func testAccClusterConfig_AllowMajorVersionUpgradeWithCustomParameters(rName string, allowMajorVersionUpgrade bool, engine string, engineVersion string, applyImmediate bool) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -43,6 +43,18 @@ const (
 	InstanceAutomatedBackupStatusRetained    = "retained"
 )
 
+// https://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_BlueGreenDeployment.html.
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusProvisioningFailed   = "PROVISIONING_FAILED"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
@@ -315,3 +315,37 @@ func findDBInstanceAutomatedBackups(conn *rds.RDS, input *rds.DescribeDBInstance
 
 	return output, nil
 }
+
+func FindBlueGreenDeploymentByID(conn *rds.RDS, id string) (*rds.BlueGreenDeployment, error) {
+	input := &rds.DescribeBlueGreenDeploymentsInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeBlueGreenDeployments(input)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.BlueGreenDeployments) == 0 || output.BlueGreenDeployments[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	deployment := output.BlueGreenDeployments[0]
+
+	// Eventual consistency check.
+	if aws.StringValue(deployment.BlueGreenDeploymentIdentifier) != id {
+		return nil, &resource.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return deployment, nil
+}
@@ -119,6 +119,7 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_update": blueGreenUpdateSchema(),
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1622,6 +1623,16 @@ func waitUntilDBInstanceAvailableAfterUpdate(id string, conn *rds.RDS, timeout t
 func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RDSConn
 
+	// Engine version and parameter group changes are applied to a green environment
+	// which is then switched over to, instead of modifying the instance in place.
+	blueGreenUpdate := d.HasChanges("engine_version", "parameter_group_name") && blueGreenUpdateEnabled(d)
+
+	if blueGreenUpdate {
+		if err := resourceInstanceBlueGreenUpdate(d, conn); err != nil {
+			return err
+		}
+	}
+
 	req := &rds.ModifyDBInstanceInput{
 		ApplyImmediately:     aws.Bool(d.Get("apply_immediately").(bool)),
 		DBInstanceIdentifier: aws.String(d.Id()),
@@ -1666,11 +1677,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		req.DBInstanceClass = aws.String(d.Get("instance_class").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("parameter_group_name") {
+	if d.HasChange("parameter_group_name") && !blueGreenUpdate {
 		req.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("engine_version") {
+	if d.HasChange("engine_version") && !blueGreenUpdate {
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
 		req.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		requestUpdate = true
@@ -1882,6 +1893,60 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceInstanceRead(d, meta)
 }
 
+func resourceInstanceBlueGreenUpdate(d *schema.ResourceData, conn *rds.RDS) error {
+	if err := blueGreenDeleteSourceCheck(d); err != nil {
+		return fmt.Errorf("error updating DB Instance (%s) using blue/green deployment: %w", d.Id(), err)
+	}
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(resource.UniqueId()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	if d.HasChange("parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+	}
+
+	deployment, err := blueGreenSwitchover(conn, input, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("error updating DB Instance (%s) using blue/green deployment: %w", d.Id(), err)
+	}
+
+	_, instanceIDs, err := blueGreenSourceMembers(deployment)
+
+	if err != nil {
+		return fmt.Errorf("error reading DB Instance (%s) blue/green deployment source: %w", d.Id(), err)
+	}
+
+	// The old instance is kept unless its deletion has been opted into.
+	if !blueGreenUpdateDeleteSource(d) {
+		for _, id := range instanceIDs {
+			log.Printf("[INFO] Keeping DB Instance (%s) blue/green deployment source: %s", d.Id(), id)
+		}
+
+		return nil
+	}
+
+	for _, id := range instanceIDs {
+		skipFinalSnapshot, finalSnapshotID, err := blueGreenSourceFinalSnapshotIdentifier(d, id)
+
+		if err != nil {
+			return fmt.Errorf("error deleting DB Instance (%s) blue/green deployment source: %w", d.Id(), err)
+		}
+
+		if err := blueGreenDeleteSourceInstance(conn, id, skipFinalSnapshot, finalSnapshotID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error deleting DB Instance (%s) blue/green deployment source: %w", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
 // resourceInstanceRetrieve fetches DBInstance information from the AWS
 // API. It returns an error if there is a communication problem or unexpected
 // error with AWS. When the DBInstance is not found, it returns no error and a
@@ -451,6 +451,48 @@ func TestAccRDSInstance_allowMajorVersionUpgrade(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance1, dbInstance2 rds.DBInstance
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+	// If these hardcoded versions become a maintenance burden, use DescribeDBEngineVersions.
+	engineVersion1 := "5.7"
+	engineVersion2 := "8.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_EngineVersion(rName, engineVersion1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.delete_source", "true"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", engineVersion1),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_EngineVersion(rName, engineVersion2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceRecreated(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "engine_version", engineVersion2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_dbSubnetGroupName(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -3786,6 +3828,15 @@ func testAccCheckInstanceNotRecreated(instance1, instance2 *rds.DBInstance) reso
 	}
 }
 
+func testAccCheckInstanceRecreated(instance1, instance2 *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.TimeValue(instance1.InstanceCreateTime).Equal(aws.TimeValue(instance2.InstanceCreateTime)) {
+			return fmt.Errorf("database instance was not recreated")
+		}
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -5987,6 +6038,37 @@ resource "aws_db_instance" "test" {
 `, allowMajorVersionUpgrade, rName))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_EngineVersion(rName, engineVersion string) string {
+	return fmt.Sprintf(`
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = "mysql"
+  engine_version             = %[2]q
+  license_model              = "general-public-license"
+  storage_type               = "standard"
+  preferred_instance_classes = ["db.t3.micro", "db.t2.micro", "db.t3.small"]
+}
+
+resource "aws_db_instance" "test" {
+  allocated_storage           = 10
+  allow_major_version_upgrade = true
+  apply_immediately           = true
+  backup_retention_period     = 1
+  engine                      = data.aws_rds_orderable_db_instance.test.engine
+  engine_version              = %[2]q
+  identifier                  = %[1]q
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  password                    = "barbarbarbar"
+  skip_final_snapshot         = true
+  username                    = "foo"
+
+  blue_green_update {
+    delete_source = true
+    enabled       = true
+  }
+}
+`, rName, engineVersion)
+}
+
 func testAccInstanceConfig_AutoMinorVersion(rName string) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQL(),
@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"log"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -157,3 +158,27 @@ func statusDBProxy(conn *rds.RDS, name string) resource.StateRefreshFunc {
 		return output, aws.StringValue(output.Status), nil
 	}
 }
+
+func statusBlueGreenDeployment(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindBlueGreenDeploymentByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, v := range output.Tasks {
+			log.Printf("[DEBUG] RDS Blue/Green Deployment (%s) task %s: %s", id, aws.StringValue(v.Name), aws.StringValue(v.Status))
+		}
+
+		for _, v := range output.SwitchoverDetails {
+			log.Printf("[DEBUG] RDS Blue/Green Deployment (%s) member %s: %s", id, aws.StringValue(v.SourceMember), aws.StringValue(v.Status))
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -2,13 +2,16 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -17,6 +20,8 @@ const (
 
 	dbClusterActivityStreamStartedTimeout = 30 * time.Minute
 	dbClusterActivityStreamStoppedTimeout = 30 * time.Minute
+
+	blueGreenDeploymentDeletedTimeout = 20 * time.Minute
 )
 
 func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -333,3 +338,78 @@ func waitDBProxyUpdated(conn *rds.RDS, name string, timeout time.Duration) (*rds
 
 	return nil, err
 }
+
+func waitBlueGreenDeploymentAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusProvisioning},
+		Target:     []string{BlueGreenDeploymentStatusAvailable},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		if statusDetails := aws.StringValue(output.StatusDetails); statusDetails != "" {
+			tfresource.SetLastError(err, errors.New(statusDetails))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentSwitchoverCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusSwitchoverInProgress,
+		},
+		Target:     []string{BlueGreenDeploymentStatusSwitchoverCompleted},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		if statusDetails := aws.StringValue(output.StatusDetails); statusDetails != "" {
+			tfresource.SetLastError(err, errors.New(statusDetails))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusDeleting,
+			BlueGreenDeploymentStatusInvalidConfiguration,
+			BlueGreenDeploymentStatusProvisioning,
+			BlueGreenDeploymentStatusProvisioningFailed,
+			BlueGreenDeploymentStatusSwitchoverCompleted,
+			BlueGreenDeploymentStatusSwitchoverFailed,
+		},
+		Target:     []string{},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -63,20 +63,10 @@ func resourceBucketPolicyPut(d *schema.ResourceData, meta interface{}) error {
 		Policy: aws.String(policy),
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		_, err := conn.PutBucketPolicy(params)
-		if tfawserr.ErrCodeEquals(err, "MalformedPolicy") {
-			return resource.RetryableError(err)
-		}
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.PutBucketPolicy(params)
-	}
-	if err != nil {
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
+	if err := putBucketPolicy(conn, params); err != nil {
 		return fmt.Errorf("Error putting S3 policy: %s", err)
 	}
 
@@ -132,6 +122,9 @@ func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error
 
 	bucket := d.Get("bucket").(string)
 
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
 	log.Printf("[DEBUG] S3 bucket: %s, delete policy", bucket)
 	_, err := conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
 		Bucket: aws.String(bucket),
@@ -146,3 +139,21 @@ func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+func putBucketPolicy(conn *s3.S3, input *s3.PutBucketPolicyInput) error {
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutBucketPolicy(input)
+		if tfawserr.ErrCodeEquals(err, ErrCodeMalformedPolicy) {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if tfresource.TimedOut(err) {
+		_, err = conn.PutBucketPolicy(input)
+	}
+
+	return err
+}
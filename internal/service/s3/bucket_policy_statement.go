@@ -0,0 +1,498 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	bucketPolicyVersion = "2012-10-17"
+)
+
+func ResourceBucketPolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketPolicyStatementCreate,
+		Read:   resourceBucketPolicyStatementRead,
+		Update: resourceBucketPolicyStatementUpdate,
+		Delete: resourceBucketPolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceBucketPolicyStatementCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"policy": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validBucketPolicyStatements,
+				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+			},
+			"sids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceBucketPolicyStatementCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	statements, err := expandBucketPolicyStatements(d.Get("policy").(string))
+
+	if err != nil {
+		return err
+	}
+
+	sids := bucketPolicyStatementSids(statements)
+
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if tfresource.NotFound(err) {
+		document = &bucketPolicyDocument{Version: bucketPolicyVersion}
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	for _, sid := range sids {
+		if document.statement(sid) != nil {
+			return fmt.Errorf("error creating S3 Bucket (%s) Policy Statement: statement with Sid (%s) already exists in the bucket policy and is managed elsewhere", bucket, sid)
+		}
+	}
+
+	document.Statements = append(document.Statements, statements...)
+
+	if err := putBucketPolicyDocument(conn, bucket, document); err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) Policy Statement: %w", bucket, err)
+	}
+
+	d.SetId(BucketPolicyStatementCreateResourceID(bucket, sids))
+
+	return resourceBucketPolicyStatementRead(d, meta)
+}
+
+func resourceBucketPolicyStatementRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, sids, err := BucketPolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	policy, err := FindBucketPolicyStatements(conn, bucket, sids)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Bucket Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket Policy Statement (%s): %w", d.Id(), err)
+	}
+
+	policyToSet, err := verify.PolicyToSet(d.Get("policy").(string), policy)
+
+	if err != nil {
+		return err
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("policy", policyToSet)
+	d.Set("sids", sids)
+
+	return nil
+}
+
+func resourceBucketPolicyStatementUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, sids, err := BucketPolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	statements, err := expandBucketPolicyStatements(d.Get("policy").(string))
+
+	if err != nil {
+		return err
+	}
+
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if tfresource.NotFound(err) {
+		document = &bucketPolicyDocument{Version: bucketPolicyVersion}
+	} else if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	document.removeStatements(sids)
+	document.Statements = append(document.Statements, statements...)
+
+	if err := putBucketPolicyDocument(conn, bucket, document); err != nil {
+		return fmt.Errorf("error updating S3 Bucket Policy Statement (%s): %w", d.Id(), err)
+	}
+
+	return resourceBucketPolicyStatementRead(d, meta)
+}
+
+func resourceBucketPolicyStatementDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, sids, err := BucketPolicyStatementParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	conns.GlobalMutexKV.Lock(bucketPolicyMutexKey(bucket))
+	defer conns.GlobalMutexKV.Unlock(bucketPolicyMutexKey(bucket))
+
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) Policy: %w", bucket, err)
+	}
+
+	if !document.removeStatements(sids) {
+		return nil
+	}
+
+	if len(document.Statements) == 0 {
+		log.Printf("[DEBUG] Deleting S3 Bucket (%s) Policy", bucket)
+		_, err = conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
+			Bucket: aws.String(bucket),
+		})
+	} else {
+		err = putBucketPolicyDocument(conn, bucket, document)
+	}
+
+	if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting S3 Bucket Policy Statement (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBucketPolicyStatementCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("policy") {
+		return nil
+	}
+
+	// The set of owned Sids is part of the resource ID, so adding, removing or renaming a statement replaces the resource.
+	o, n := diff.GetChange("policy")
+
+	oldStatements, err := expandBucketPolicyStatements(o.(string))
+
+	if err != nil {
+		return nil
+	}
+
+	newStatements, err := expandBucketPolicyStatements(n.(string))
+
+	if err != nil {
+		// Policy may not be known until apply.
+		return nil
+	}
+
+	if strings.Join(bucketPolicyStatementSids(oldStatements), resourceIDSeparator) != strings.Join(bucketPolicyStatementSids(newStatements), resourceIDSeparator) {
+		return diff.ForceNew("policy")
+	}
+
+	return nil
+}
+
+func bucketPolicyMutexKey(bucket string) string {
+	return fmt.Sprintf("s3-bucket-policy-%s", bucket)
+}
+
+// BucketPolicyStatementCreateResourceID returns an ID of the form BUCKET,SID1[,SID2...].
+func BucketPolicyStatementCreateResourceID(bucket string, sids []string) string {
+	parts := append([]string{bucket}, sids...)
+
+	return strings.Join(parts, resourceIDSeparator)
+}
+
+// BucketPolicyStatementParseResourceID parses an ID of the form BUCKET,SID1[,SID2...].
+func BucketPolicyStatementParseResourceID(id string) (string, []string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) < 2 {
+		return "", nil, fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sSID[%[2]sSID...]", id, resourceIDSeparator)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return "", nil, fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sSID[%[2]sSID...]", id, resourceIDSeparator)
+		}
+	}
+
+	return parts[0], parts[1:], nil
+}
+
+func findBucketPolicyDocument(conn *s3.S3, bucket string) (*bucketPolicyDocument, error) {
+	input := &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	}
+
+	output, err := conn.GetBucketPolicy(input)
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeNoSuchBucketPolicy, s3.ErrCodeNoSuchBucket) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.StringValue(output.Policy) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return expandBucketPolicyDocument(aws.StringValue(output.Policy))
+}
+
+// FindBucketPolicyStatements returns a policy document containing only the
+// statements with the specified Sids from the bucket's policy.
+func FindBucketPolicyStatements(conn *s3.S3, bucket string, sids []string) (string, error) {
+	document, err := findBucketPolicyDocument(conn, bucket)
+
+	if err != nil {
+		return "", err
+	}
+
+	owned := &bucketPolicyDocument{Version: document.Version}
+
+	for _, sid := range sids {
+		if statement := document.statement(sid); statement != nil {
+			owned.Statements = append(owned.Statements, statement)
+		}
+	}
+
+	if len(owned.Statements) == 0 {
+		return "", &resource.NotFoundError{
+			Message: fmt.Sprintf("no statements with Sids (%s) found in S3 Bucket (%s) Policy", strings.Join(sids, ", "), bucket),
+		}
+	}
+
+	return owned.String()
+}
+
+func putBucketPolicyDocument(conn *s3.S3, bucket string, document *bucketPolicyDocument) error {
+	policy, err := document.String()
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] S3 bucket: %s, put policy: %s", bucket, policy)
+	return putBucketPolicy(conn, &s3.PutBucketPolicyInput{
+		Bucket: aws.String(bucket),
+		Policy: aws.String(policy),
+	})
+}
+
+// bucketPolicyDocument is a bucket policy whose statements are kept as raw JSON
+// so that statements not owned by a resource are written back unmodified.
+type bucketPolicyDocument struct {
+	Version    string            `json:"Version,omitempty"`
+	ID         string            `json:"Id,omitempty"`
+	Statements []json.RawMessage `json:"Statement"`
+}
+
+func (doc *bucketPolicyDocument) String() (string, error) {
+	b, err := json.Marshal(doc)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(b))
+}
+
+func (doc *bucketPolicyDocument) statement(sid string) json.RawMessage {
+	for _, statement := range doc.Statements {
+		if bucketPolicyStatementSid(statement) == sid {
+			return statement
+		}
+	}
+
+	return nil
+}
+
+// removeStatements removes any statements with the specified Sids and reports whether any were removed.
+func (doc *bucketPolicyDocument) removeStatements(sids []string) bool {
+	remove := make(map[string]bool, len(sids))
+
+	for _, sid := range sids {
+		remove[sid] = true
+	}
+
+	statements := make([]json.RawMessage, 0, len(doc.Statements))
+
+	for _, statement := range doc.Statements {
+		if sid := bucketPolicyStatementSid(statement); sid != "" && remove[sid] {
+			continue
+		}
+
+		statements = append(statements, statement)
+	}
+
+	removed := len(statements) != len(doc.Statements)
+	doc.Statements = statements
+
+	return removed
+}
+
+func expandBucketPolicyDocument(policy string) (*bucketPolicyDocument, error) {
+	var raw struct {
+		Version   string          `json:"Version"`
+		ID        string          `json:"Id"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+
+	if err := json.Unmarshal([]byte(policy), &raw); err != nil {
+		return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	doc := &bucketPolicyDocument{
+		Version: raw.Version,
+		ID:      raw.ID,
+	}
+
+	statement := bytes.TrimSpace(raw.Statement)
+
+	switch {
+	case len(statement) == 0 || bytes.Equal(statement, []byte("null")):
+	case statement[0] == '[':
+		if err := json.Unmarshal(statement, &doc.Statements); err != nil {
+			return nil, fmt.Errorf("policy (%s) has invalid Statement: %w", policy, err)
+		}
+	default:
+		doc.Statements = []json.RawMessage{statement}
+	}
+
+	return doc, nil
+}
+
+// expandBucketPolicyStatements returns the statements in the specified policy document.
+// Each statement must have a unique, non-empty Sid.
+func expandBucketPolicyStatements(policy string) ([]json.RawMessage, error) {
+	doc, err := expandBucketPolicyDocument(policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(doc.Statements) == 0 {
+		return nil, fmt.Errorf("policy must contain at least one statement")
+	}
+
+	seen := make(map[string]bool)
+
+	for _, statement := range doc.Statements {
+		sid := bucketPolicyStatementSid(statement)
+
+		if sid == "" {
+			return nil, fmt.Errorf("each policy statement must have a non-empty Sid")
+		}
+
+		if strings.Contains(sid, resourceIDSeparator) {
+			return nil, fmt.Errorf("policy statement Sid (%s) must not contain %q", sid, resourceIDSeparator)
+		}
+
+		if seen[sid] {
+			return nil, fmt.Errorf("duplicate policy statement Sid (%s)", sid)
+		}
+
+		seen[sid] = true
+	}
+
+	return doc.Statements, nil
+}
+
+func bucketPolicyStatementSid(statement json.RawMessage) string {
+	var v struct {
+		Sid string `json:"Sid"`
+	}
+
+	if err := json.Unmarshal(statement, &v); err != nil {
+		return ""
+	}
+
+	return v.Sid
+}
+
+// bucketPolicyStatementSids returns the sorted Sids of the specified statements.
+func bucketPolicyStatementSids(statements []json.RawMessage) []string {
+	var sids []string
+
+	for _, statement := range statements {
+		sids = append(sids, bucketPolicyStatementSid(statement))
+	}
+
+	sort.Strings(sids)
+
+	return sids
+}
+
+func validBucketPolicyStatements(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := expandBucketPolicyStatements(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
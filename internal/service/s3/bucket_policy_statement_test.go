@@ -0,0 +1,313 @@
+package s3_test
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestBucketPolicyStatementParseResourceID(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		InputID        string
+		ExpectError    bool
+		ExpectedBucket string
+		ExpectedSids   []string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "bucket only",
+			InputID:     "example",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty Sid",
+			InputID:     "example,",
+			ExpectError: true,
+		},
+		{
+			TestName:       "single Sid",
+			InputID:        "example,DenyInsecureTransport",
+			ExpectedBucket: "example",
+			ExpectedSids:   []string{"DenyInsecureTransport"},
+		},
+		{
+			TestName:       "multiple Sids",
+			InputID:        "example,AllowLogDeliveryAclCheck,AllowLogDeliveryWrite",
+			ExpectedBucket: "example",
+			ExpectedSids:   []string{"AllowLogDeliveryAclCheck", "AllowLogDeliveryWrite"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotBucket, gotSids, err := tfs3.BucketPolicyStatementParseResourceID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotBucket != testCase.ExpectedBucket {
+				t.Errorf("got bucket %s, expected %s", gotBucket, testCase.ExpectedBucket)
+			}
+
+			if !reflect.DeepEqual(gotSids, testCase.ExpectedSids) {
+				t.Errorf("got Sids %v, expected %v", gotSids, testCase.ExpectedSids)
+			}
+		})
+	}
+}
+
+func TestAccS3BucketPolicyStatement_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket", "aws_s3_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "sids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sids.0", "DenyInsecureTransport"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_disappears(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy_statement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfs3.ResourceBucketPolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_multiple(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_s3_bucket_policy_statement.test"
+	resourceName2 := "aws_s3_bucket_policy_statement.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketPolicyStatementConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName1),
+					testAccCheckBucketPolicyStatementExists(resourceName2),
+					resource.TestCheckResourceAttr(resourceName2, "sids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName2, "sids.0", "AllowLogDeliveryAclCheck"),
+					resource.TestCheckResourceAttr(resourceName2, "sids.1", "AllowLogDeliveryWrite"),
+				),
+			},
+			{
+				Config: testAccBucketPolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketPolicyStatementExists(resourceName1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketPolicyStatement_collision(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketPolicyStatementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketPolicyStatementConfig_collision(rName),
+				ExpectError: regexp.MustCompile(`statement with Sid \(DenyInsecureTransport\) already exists`),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketPolicyStatementDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3_bucket_policy_statement" {
+			continue
+		}
+
+		bucket, sids, err := tfs3.BucketPolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfs3.FindBucketPolicyStatements(conn, bucket, sids)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Bucket Policy Statement %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckBucketPolicyStatementExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Bucket Policy Statement ID is set")
+		}
+
+		bucket, sids, err := tfs3.BucketPolicyStatementParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		_, err = tfs3.FindBucketPolicyStatements(conn, bucket, sids)
+
+		return err
+	}
+}
+
+func testAccBucketPolicyStatementBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+data "aws_iam_policy_document" "deny_insecure_transport" {
+  statement {
+    sid     = "DenyInsecureTransport"
+    effect  = "Deny"
+    actions = ["s3:*"]
+
+    resources = [
+      aws_s3_bucket.test.arn,
+      "${aws_s3_bucket.test.arn}/*",
+    ]
+
+    principals {
+      type        = "*"
+      identifiers = ["*"]
+    }
+
+    condition {
+      test     = "Bool"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+`, rName)
+}
+
+func testAccBucketPolicyStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementBaseConfig(rName), `
+resource "aws_s3_bucket_policy_statement" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  policy = data.aws_iam_policy_document.deny_insecure_transport.json
+}
+`)
+}
+
+func testAccBucketPolicyStatementConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementConfig_basic(rName), `
+data "aws_iam_policy_document" "log_delivery" {
+  statement {
+    sid       = "AllowLogDeliveryAclCheck"
+    actions   = ["s3:GetBucketAcl"]
+    resources = [aws_s3_bucket.test.arn]
+
+    principals {
+      type        = "Service"
+      identifiers = ["delivery.logs.amazonaws.com"]
+    }
+  }
+
+  statement {
+    sid       = "AllowLogDeliveryWrite"
+    actions   = ["s3:PutObject"]
+    resources = ["${aws_s3_bucket.test.arn}/*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["delivery.logs.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_s3_bucket_policy_statement" "test2" {
+  bucket = aws_s3_bucket.test.bucket
+  policy = data.aws_iam_policy_document.log_delivery.json
+}
+`)
+}
+
+func testAccBucketPolicyStatementConfig_collision(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyStatementConfig_basic(rName), `
+resource "aws_s3_bucket_policy_statement" "test2" {
+  bucket = aws_s3_bucket_policy_statement.test.bucket
+  policy = data.aws_iam_policy_document.deny_insecure_transport.json
+}
+`)
+}
@@ -0,0 +1,550 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	directorySyncIDSeparator = "/"
+
+	directorySyncDefaultContentType = "application/octet-stream"
+
+	// directorySyncPartSize is the multipart upload part size used for all uploads.
+	// It's fixed so that the expected ETag of a multipart upload can be computed locally.
+	directorySyncPartSize = 16 * 1024 * 1024
+
+	directorySyncConcurrency = 10
+)
+
+func ResourceDirectorySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDirectorySyncCreate,
+		ReadContext:   resourceDirectorySyncRead,
+		UpdateContext: resourceDirectorySyncUpdate,
+		DeleteContext: resourceDirectorySyncDelete,
+
+		CustomizeDiff: resourceDirectorySyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3.ObjectCannedACLPrivate,
+				ValidateFunc: validation.StringInSlice(s3.ObjectCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"delete_removed_objects": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"file_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateMetadataIsLowerCase,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validDirectorySyncPattern,
+						},
+					},
+				},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`/$`), "must end with a slash (/)"),
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"manifest_digest": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"storage_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(s3.ObjectStorageClass_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceDirectorySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+	id := DirectorySyncCreateResourceID(bucket, keyPrefix)
+
+	manifest, err := directorySyncLocalManifest(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := directorySyncApply(ctx, conn, d, manifest, true); err != nil {
+		return diag.Errorf("error creating S3 Directory Sync (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set("manifest_digest", manifest.digest())
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, keyPrefix, err := DirectorySyncParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := FindObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Directory Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("key_prefix", keyPrefix)
+
+	manifest, err := directorySyncLocalManifest(d)
+
+	if err != nil {
+		// The source directory may no longer be available, e.g. when running in a different workspace.
+		log.Printf("[WARN] Unable to read S3 Directory Sync (%s) source, skipping drift detection: %s", d.Id(), err)
+		return nil
+	}
+
+	// Only compare content when S3 reports an MD5-based ETag.
+	compareETags := d.Get("server_side_encryption").(string) != s3.ServerSideEncryptionAwsKms && d.Get("kms_key_id").(string) == ""
+
+	// The manifest digest is only recorded on apply; clearing it here causes the next plan to re-sync.
+	if manifest.drifted(remote, compareETags, d.Get("delete_removed_objects").(bool)) {
+		log.Printf("[DEBUG] S3 Directory Sync (%s) objects differ from source", d.Id())
+		d.Set("manifest_digest", "")
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	manifest, err := directorySyncLocalManifest(d)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Object settings are not recorded in state so any change to them re-uploads every object.
+	uploadAll := d.HasChanges("acl", "file_rule", "kms_key_id", "server_side_encryption", "storage_class")
+
+	if err := directorySyncApply(ctx, conn, d, manifest, uploadAll); err != nil {
+		return diag.Errorf("error updating S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	d.Set("manifest_digest", manifest.digest())
+
+	return resourceDirectorySyncRead(ctx, d, meta)
+}
+
+func resourceDirectorySyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket, keyPrefix, err := DirectorySyncParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var keys []string
+
+	if d.Get("delete_removed_objects").(bool) && keyPrefix != "" {
+		// The resource owns every object under the key prefix.
+		remote, err := FindObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return diag.Errorf("error deleting S3 Directory Sync (%s): listing objects: %s", d.Id(), err)
+		}
+
+		for key := range remote {
+			keys = append(keys, key)
+		}
+	} else {
+		// Object keys are not recorded in state, so the objects to delete are those of the current source directory.
+		manifest, err := directorySyncLocalManifest(d)
+
+		if err != nil {
+			log.Printf("[WARN] Unable to read S3 Directory Sync (%s) source, leaving objects in place: %s", d.Id(), err)
+			return nil
+		}
+
+		keys = manifest.keys()
+	}
+
+	log.Printf("[DEBUG] Deleting S3 Directory Sync (%s): %d objects", d.Id(), len(keys))
+	if err := deleteObjectsByKey(ctx, conn, bucket, keys); err != nil {
+		return diag.Errorf("error deleting S3 Directory Sync (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDirectorySyncCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Deleting removed objects without a key prefix would delete every other object in the bucket.
+	if diff.NewValueKnown("delete_removed_objects") && diff.NewValueKnown("key_prefix") {
+		if diff.Get("delete_removed_objects").(bool) && diff.Get("key_prefix").(string) == "" {
+			return fmt.Errorf("key_prefix must be set when delete_removed_objects is true")
+		}
+	}
+
+	for _, k := range []string{"acl", "file_rule", "key_prefix", "kms_key_id", "server_side_encryption", "source", "storage_class"} {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("manifest_digest")
+		}
+	}
+
+	manifest, err := directorySyncLocalManifest(diff)
+
+	if errors.Is(err, os.ErrNotExist) {
+		// The source directory may be created during apply.
+		log.Printf("[DEBUG] S3 Directory Sync source not found, manifest digest will be computed during apply: %s", err)
+		return diff.SetNewComputed("manifest_digest")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if digest := manifest.digest(); digest != diff.Get("manifest_digest").(string) {
+		return diff.SetNew("manifest_digest", digest)
+	}
+
+	return nil
+}
+
+// DirectorySyncCreateResourceID returns an ID of the form BUCKET/KEY_PREFIX.
+func DirectorySyncCreateResourceID(bucket, keyPrefix string) string {
+	return bucket + directorySyncIDSeparator + keyPrefix
+}
+
+// DirectorySyncParseResourceID parses an ID of the form BUCKET/KEY_PREFIX.
+// Bucket names cannot contain "/" so the first separator always ends the bucket name.
+func DirectorySyncParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, directorySyncIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET%[2]sKEY_PREFIX", id, directorySyncIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// FindObjectETagsByPrefix returns the ETags of all objects under the specified key prefix, keyed by object key.
+func FindObjectETagsByPrefix(ctx context.Context, conn *s3.S3, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	etags := make(map[string]string)
+
+	err := conn.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Contents {
+			etags[aws.StringValue(v.Key)] = strings.Trim(aws.StringValue(v.ETag), `"`)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return etags, nil
+}
+
+// directorySyncApply uploads new and changed files and, if configured, deletes remote objects not present in the manifest.
+func directorySyncApply(ctx context.Context, conn *s3.S3, d *schema.ResourceData, manifest *directorySyncManifest, uploadAll bool) error {
+	bucket := d.Get("bucket").(string)
+	keyPrefix := d.Get("key_prefix").(string)
+
+	remote, err := FindObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		return fmt.Errorf("listing objects: %w", err)
+	}
+
+	var uploads []*directorySyncEntry
+
+	for _, entry := range manifest.Entries {
+		if etag, ok := remote[entry.Key]; !uploadAll && ok && etag == entry.ETag {
+			continue
+		}
+
+		uploads = append(uploads, entry)
+	}
+
+	log.Printf("[DEBUG] Uploading %d of %d files to S3 Bucket (%s)", len(uploads), len(manifest.Entries), bucket)
+	if err := directorySyncUpload(ctx, conn, d, uploads); err != nil {
+		return err
+	}
+
+	if !d.Get("delete_removed_objects").(bool) {
+		return nil
+	}
+
+	local := make(map[string]bool, len(manifest.Entries))
+
+	for _, entry := range manifest.Entries {
+		local[entry.Key] = true
+	}
+
+	var removed []string
+
+	for key := range remote {
+		if !local[key] {
+			removed = append(removed, key)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting %d removed objects from S3 Bucket (%s)", len(removed), bucket)
+	return deleteObjectsByKey(ctx, conn, bucket, removed)
+}
+
+func directorySyncUpload(ctx context.Context, conn *s3.S3, d *schema.ResourceData, entries []*directorySyncEntry) error {
+	uploader := s3manager.NewUploaderWithClient(conn, func(u *s3manager.Uploader) {
+		u.PartSize = directorySyncPartSize
+	})
+
+	bucket := d.Get("bucket").(string)
+
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, directorySyncConcurrency)
+
+	for _, entry := range entries {
+		entry := entry
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := directorySyncUploadEntry(ctx, uploader, d, bucket, entry); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func directorySyncUploadEntry(ctx context.Context, uploader *s3manager.Uploader, d *schema.ResourceData, bucket string, entry *directorySyncEntry) error {
+	file, err := os.Open(entry.Path)
+
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", entry.Path, err)
+	}
+
+	defer func() {
+		if err := file.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 Directory Sync source file (%s): %s", entry.Path, err)
+		}
+	}()
+
+	input := &s3manager.UploadInput{
+		ACL:         aws.String(d.Get("acl").(string)),
+		Body:        file,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(entry.ContentType),
+		Key:         aws.String(entry.Key),
+	}
+
+	if entry.CacheControl != "" {
+		input.CacheControl = aws.String(entry.CacheControl)
+	}
+
+	if entry.ContentDisposition != "" {
+		input.ContentDisposition = aws.String(entry.ContentDisposition)
+	}
+
+	if entry.ContentEncoding != "" {
+		input.ContentEncoding = aws.String(entry.ContentEncoding)
+	}
+
+	if entry.ContentLanguage != "" {
+		input.ContentLanguage = aws.String(entry.ContentLanguage)
+	}
+
+	if len(entry.Metadata) > 0 {
+		input.Metadata = aws.StringMap(entry.Metadata)
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
+	}
+
+	if _, err := uploader.UploadWithContext(ctx, input); err != nil {
+		return fmt.Errorf("uploading %s to %s: %w", entry.Path, entry.Key, err)
+	}
+
+	return nil
+}
+
+// deleteObjectsByKey deletes the specified objects in batches of up to 1000 keys.
+func deleteObjectsByKey(ctx context.Context, conn *s3.S3, bucket string, keys []string) error {
+	const (
+		maxKeys = 1000
+	)
+
+	var errs *multierror.Error
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxKeys {
+			n = maxKeys
+		}
+
+		objects := make([]*s3.ObjectIdentifier, 0, n)
+		for _, key := range keys[:n] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		keys = keys[n:]
+
+		output, err := conn.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true), // Only report errors.
+			},
+		})
+
+		if tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range output.Errors {
+			errs = multierror.Append(errs, fmt.Errorf("deleting S3 Bucket (%s) object (%s): %s: %s", bucket, aws.StringValue(v.Key), aws.StringValue(v.Code), aws.StringValue(v.Message)))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,373 @@
+package s3
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/mitchellh/go-homedir"
+)
+
+type directorySyncEntry struct {
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	ContentType        string
+	ETag               string
+	Key                string
+	Metadata           map[string]string
+	Path               string
+}
+
+type directorySyncManifest struct {
+	// Settings that apply to every object.
+	ACL                  string
+	KMSKeyID             string
+	ServerSideEncryption string
+	StorageClass         string
+
+	Entries []*directorySyncEntry
+}
+
+// digest returns a stable SHA-256 digest of the manifest.
+func (m *directorySyncManifest) digest() string {
+	h := sha256.New()
+
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\n", m.ACL, m.KMSKeyID, m.ServerSideEncryption, m.StorageClass)
+
+	for _, entry := range m.Entries {
+		fmt.Fprintf(h, "%s\x00%s\x00%s\x00%s\x00%s\x00%s\x00%s", entry.Key, entry.ETag, entry.ContentType, entry.CacheControl, entry.ContentDisposition, entry.ContentEncoding, entry.ContentLanguage)
+
+		keys := make([]string, 0, len(entry.Metadata))
+		for k := range entry.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			fmt.Fprintf(h, "\x00%s=%s", k, entry.Metadata[k])
+		}
+
+		fmt.Fprint(h, "\n")
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// keys returns the object keys of the manifest's entries.
+func (m *directorySyncManifest) keys() []string {
+	keys := make([]string, 0, len(m.Entries))
+
+	for _, entry := range m.Entries {
+		keys = append(keys, entry.Key)
+	}
+
+	return keys
+}
+
+// drifted reports whether the remote objects differ from the manifest.
+func (m *directorySyncManifest) drifted(remote map[string]string, compareETags, deleteRemoved bool) bool {
+	for _, entry := range m.Entries {
+		etag, ok := remote[entry.Key]
+
+		if !ok || (compareETags && etag != entry.ETag) {
+			return true
+		}
+	}
+
+	return deleteRemoved && len(remote) != len(m.Entries)
+}
+
+type directorySyncFileRule struct {
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	ContentType        string
+	Metadata           map[string]string
+	Pattern            string
+}
+
+func expandDirectorySyncFileRules(tfList []interface{}) []*directorySyncFileRule {
+	var rules []*directorySyncFileRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rule := &directorySyncFileRule{}
+
+		if v, ok := tfMap["cache_control"].(string); ok {
+			rule.CacheControl = v
+		}
+
+		if v, ok := tfMap["content_disposition"].(string); ok {
+			rule.ContentDisposition = v
+		}
+
+		if v, ok := tfMap["content_encoding"].(string); ok {
+			rule.ContentEncoding = v
+		}
+
+		if v, ok := tfMap["content_language"].(string); ok {
+			rule.ContentLanguage = v
+		}
+
+		if v, ok := tfMap["content_type"].(string); ok {
+			rule.ContentType = v
+		}
+
+		if v, ok := tfMap["metadata"].(map[string]interface{}); ok && len(v) > 0 {
+			rule.Metadata = aws.StringValueMap(flex.ExpandStringMap(v))
+		}
+
+		if v, ok := tfMap["pattern"].(string); ok {
+			rule.Pattern = v
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// directorySyncLocalManifest walks the source directory and builds the manifest of objects to upload.
+// Every matching file rule is applied in order, with later rules overriding earlier ones.
+func directorySyncLocalManifest(d interface{ Get(string) interface{} }) (*directorySyncManifest, error) {
+	source, err := homedir.Expand(d.Get("source").(string))
+
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", d.Get("source").(string), err)
+	}
+
+	keyPrefix := d.Get("key_prefix").(string)
+	rules := expandDirectorySyncFileRules(d.Get("file_rule").([]interface{}))
+
+	manifest := &directorySyncManifest{
+		ACL:                  d.Get("acl").(string),
+		KMSKeyID:             d.Get("kms_key_id").(string),
+		ServerSideEncryption: d.Get("server_side_encryption").(string),
+		StorageClass:         d.Get("storage_class").(string),
+	}
+
+	err = filepath.Walk(source, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(source, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		rel = filepath.ToSlash(rel)
+
+		etag, err := directorySyncFileETag(filePath, info.Size())
+
+		if err != nil {
+			return err
+		}
+
+		entry := &directorySyncEntry{
+			ContentType: directorySyncContentType(rel),
+			ETag:        etag,
+			Key:         keyPrefix + rel,
+			Path:        filePath,
+		}
+
+		for _, rule := range rules {
+			if !directorySyncPatternMatch(rule.Pattern, rel) {
+				continue
+			}
+
+			if rule.CacheControl != "" {
+				entry.CacheControl = rule.CacheControl
+			}
+
+			if rule.ContentDisposition != "" {
+				entry.ContentDisposition = rule.ContentDisposition
+			}
+
+			if rule.ContentEncoding != "" {
+				entry.ContentEncoding = rule.ContentEncoding
+			}
+
+			if rule.ContentLanguage != "" {
+				entry.ContentLanguage = rule.ContentLanguage
+			}
+
+			if rule.ContentType != "" {
+				entry.ContentType = rule.ContentType
+			}
+
+			for k, v := range rule.Metadata {
+				if entry.Metadata == nil {
+					entry.Metadata = make(map[string]string)
+				}
+
+				entry.Metadata[k] = v
+			}
+		}
+
+		manifest.Entries = append(manifest.Entries, entry)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// filepath.Walk visits files in lexical order, but keys are sorted explicitly so the digest is stable.
+	sort.Slice(manifest.Entries, func(i, j int) bool {
+		return manifest.Entries[i].Key < manifest.Entries[j].Key
+	})
+
+	return manifest, nil
+}
+
+// directorySyncContentType infers a content type from a file's extension.
+func directorySyncContentType(name string) string {
+	if v := mime.TypeByExtension(path.Ext(name)); v != "" {
+		return v
+	}
+
+	return directorySyncDefaultContentType
+}
+
+// directorySyncFileETag returns the ETag S3 reports for an unencrypted or SSE-S3 encrypted
+// object uploaded with a fixed part size: the MD5 of the content for single part uploads,
+// otherwise the MD5 of the concatenated part MD5s followed by the number of parts.
+func directorySyncFileETag(filePath string, size int64) (string, error) {
+	file, err := os.Open(filePath)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer file.Close()
+
+	partSize := int64(directorySyncPartSize)
+
+	// The uploader grows the part size for very large files to stay within the maximum number of parts.
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = (size / s3manager.MaxUploadParts) + 1
+	}
+
+	if size <= partSize {
+		h := md5.New()
+
+		if _, err := io.Copy(h, file); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var (
+		parts int
+		sums  []byte
+	)
+
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, file, partSize)
+
+		if n > 0 {
+			sums = append(sums, h.Sum(nil)...)
+			parts++
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sum := md5.Sum(sums)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// directorySyncPatternMatch reports whether a slash-separated relative path matches a glob pattern.
+// In addition to path.Match syntax, a "**" path segment matches zero or more path segments.
+func directorySyncPatternMatch(pattern, name string) bool {
+	return directorySyncSegmentsMatch(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func directorySyncSegmentsMatch(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			pattern = pattern[1:]
+
+			if len(pattern) == 0 {
+				return true
+			}
+
+			for i := range name {
+				if directorySyncSegmentsMatch(pattern, name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if ok, err := path.Match(pattern[0], name[0]); err != nil || !ok {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+func validDirectorySyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	for _, segment := range strings.Split(value, "/") {
+		if segment == "**" {
+			continue
+		}
+
+		if _, err := path.Match(segment, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, value, err))
+			return
+		}
+	}
+
+	return
+}
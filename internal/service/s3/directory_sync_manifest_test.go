@@ -0,0 +1,235 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirectorySyncFileETag(t *testing.T) {
+	partSize := directorySyncPartSize
+
+	md5Hex := func(b []byte) string {
+		sum := md5.Sum(b)
+		return hex.EncodeToString(sum[:])
+	}
+
+	multipartETag := func(b []byte) string {
+		var sums []byte
+		var parts int
+
+		for len(b) > 0 {
+			n := partSize
+			if n > len(b) {
+				n = len(b)
+			}
+
+			sum := md5.Sum(b[:n])
+			sums = append(sums, sum[:]...)
+			parts++
+			b = b[n:]
+		}
+
+		return fmt.Sprintf("%s-%d", md5Hex(sums), parts)
+	}
+
+	testCases := []struct {
+		Name    string
+		Size    int
+		Pattern func([]byte) string
+	}{
+		{
+			Name:    "empty",
+			Size:    0,
+			Pattern: md5Hex,
+		},
+		{
+			Name:    "small",
+			Size:    1024,
+			Pattern: md5Hex,
+		},
+		{
+			Name:    "one byte less than part size",
+			Size:    partSize - 1,
+			Pattern: md5Hex,
+		},
+		{
+			Name:    "exactly part size",
+			Size:    partSize,
+			Pattern: md5Hex,
+		},
+		{
+			Name:    "one byte more than part size",
+			Size:    partSize + 1,
+			Pattern: multipartETag,
+		},
+		{
+			Name:    "exact multiple of part size",
+			Size:    2 * partSize,
+			Pattern: multipartETag,
+		},
+	}
+
+	dir := t.TempDir()
+
+	for i, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			content := bytes.Repeat([]byte{byte('a' + i)}, testCase.Size)
+			filePath := filepath.Join(dir, fmt.Sprintf("file%d", i))
+
+			if err := os.WriteFile(filePath, content, 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := directorySyncFileETag(filePath, int64(len(content)))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.Pattern(content); got != want {
+				t.Errorf("got ETag %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDirectorySyncPatternMatch(t *testing.T) {
+	testCases := []struct {
+		Pattern string
+		Name    string
+		Match   bool
+	}{
+		{Pattern: "*.html", Name: "index.html", Match: true},
+		{Pattern: "*.html", Name: "docs/index.html", Match: false},
+		{Pattern: "*.html", Name: "index.htm", Match: false},
+		{Pattern: "docs/*.html", Name: "docs/index.html", Match: true},
+		{Pattern: "docs/*", Name: "docs/a/index.html", Match: false},
+		{Pattern: "**", Name: "index.html", Match: true},
+		{Pattern: "**", Name: "docs/a/index.html", Match: true},
+		{Pattern: "**/*.html", Name: "index.html", Match: true},
+		{Pattern: "**/*.html", Name: "docs/a/index.html", Match: true},
+		{Pattern: "**/*.html", Name: "docs/a/index.css", Match: false},
+		{Pattern: "docs/**", Name: "docs/a/index.html", Match: true},
+		{Pattern: "docs/**", Name: "assets/docs/index.html", Match: false},
+		{Pattern: "docs/**/index.html", Name: "docs/index.html", Match: true},
+		{Pattern: "docs/**/index.html", Name: "docs/a/b/index.html", Match: true},
+		{Pattern: "docs/**/index.html", Name: "docs/a/b/main.html", Match: false},
+		{Pattern: "assets/?.js", Name: "assets/a.js", Match: true},
+		{Pattern: "assets/[ab].js", Name: "assets/c.js", Match: false},
+		{Pattern: "[", Name: "[", Match: false},
+	}
+
+	for _, testCase := range testCases {
+		if got := directorySyncPatternMatch(testCase.Pattern, testCase.Name); got != testCase.Match {
+			t.Errorf("directorySyncPatternMatch(%q, %q) = %t, expected %t", testCase.Pattern, testCase.Name, got, testCase.Match)
+		}
+	}
+}
+
+func TestDirectorySyncManifestDigest(t *testing.T) {
+	manifest := func() *directorySyncManifest {
+		return &directorySyncManifest{
+			ACL:          "private",
+			StorageClass: "STANDARD",
+			Entries: []*directorySyncEntry{
+				{
+					ContentType: "text/html; charset=utf-8",
+					ETag:        "d41d8cd98f00b204e9800998ecf8427e",
+					Key:         "site/index.html",
+					Metadata: map[string]string{
+						"author":  "test",
+						"version": "1",
+					},
+					Path: "/tmp/a/index.html",
+				},
+				{
+					CacheControl: "max-age=3600",
+					ContentType:  "text/css; charset=utf-8",
+					ETag:         "0cc175b9c0f1b6a831c399e269772661",
+					Key:          "site/style.css",
+					Path:         "/tmp/a/style.css",
+				},
+			},
+		}
+	}
+
+	want := manifest().digest()
+
+	if got := manifest().digest(); got != want {
+		t.Fatalf("digest is not stable: got %q, expected %q", got, want)
+	}
+
+	// The local path of a file does not affect the objects that are uploaded.
+	m := manifest()
+	m.Entries[0].Path = "/tmp/b/index.html"
+
+	if got := m.digest(); got != want {
+		t.Errorf("digest changed with local path: got %q, expected %q", got, want)
+	}
+
+	testCases := []struct {
+		Name   string
+		Modify func(*directorySyncManifest)
+	}{
+		{
+			Name:   "acl",
+			Modify: func(m *directorySyncManifest) { m.ACL = "public-read" },
+		},
+		{
+			Name:   "kms key",
+			Modify: func(m *directorySyncManifest) { m.KMSKeyID = "arn:aws:kms:us-west-2:123456789012:key/test" },
+		},
+		{
+			Name:   "server side encryption",
+			Modify: func(m *directorySyncManifest) { m.ServerSideEncryption = "aws:kms" },
+		},
+		{
+			Name:   "storage class",
+			Modify: func(m *directorySyncManifest) { m.StorageClass = "STANDARD_IA" },
+		},
+		{
+			Name:   "key",
+			Modify: func(m *directorySyncManifest) { m.Entries[0].Key = "site/home.html" },
+		},
+		{
+			Name:   "etag",
+			Modify: func(m *directorySyncManifest) { m.Entries[1].ETag = "92eb5ffee6ae2fec3ad71c777531578f" },
+		},
+		{
+			Name:   "content type",
+			Modify: func(m *directorySyncManifest) { m.Entries[0].ContentType = "text/plain" },
+		},
+		{
+			Name:   "cache control",
+			Modify: func(m *directorySyncManifest) { m.Entries[1].CacheControl = "no-cache" },
+		},
+		{
+			Name:   "metadata value",
+			Modify: func(m *directorySyncManifest) { m.Entries[0].Metadata["version"] = "2" },
+		},
+		{
+			Name:   "metadata removed",
+			Modify: func(m *directorySyncManifest) { delete(m.Entries[0].Metadata, "author") },
+		},
+		{
+			Name:   "entry removed",
+			Modify: func(m *directorySyncManifest) { m.Entries = m.Entries[:1] },
+		},
+	}
+
+	for _, testCase := range testCases {
+		m := manifest()
+		testCase.Modify(m)
+
+		if got := m.digest(); got == want {
+			t.Errorf("%s: digest did not change", testCase.Name)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/site.css":   "body {}",
+		"img/logo.bin":   "\x00\x01",
+		"js/app/main.js": "console.log(1)",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(resourceName, 4),
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "text/html; charset=utf-8", ""),
+					testAccCheckDirectorySyncObject(resourceName, "site/css/site.css", "text/css; charset=utf-8", ""),
+					testAccCheckDirectorySyncObject(resourceName, "site/img/logo.bin", "application/octet-stream", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "manifest_digest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_fileRule(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_fileRule(rName, source, "max-age=3600"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckDirectorySyncObject(resourceName, "site/css/site.css", "text/css; charset=utf-8", "max-age=3600"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_fileRule(rName, source, "max-age=86400"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckDirectorySyncObject(resourceName, "site/css/site.css", "text/css; charset=utf-8", "max-age=86400"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteRemovedObjects(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+		"old.html":   "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteRemovedObjects(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(resourceName, 2),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "old.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteRemovedObjects(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncObjectCount(resourceName, 1),
+					testAccCheckDirectorySyncObject(resourceName, "site/index.html", "text/html; charset=utf-8", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteRemovedObjectsNoKeyPrefix(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectorySyncConfig_deleteRemovedObjectsNoKeyPrefix(rName, source),
+				ExpectError: regexp.MustCompile(`key_prefix must be set when delete_removed_objects is true`),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncSource(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func testAccCheckDirectorySyncObjectCount(n string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		bucket, keyPrefix, err := tfs3.DirectorySyncParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		etags, err := tfs3.FindObjectETagsByPrefix(context.Background(), conn, bucket, keyPrefix)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(etags); got != expected {
+			return fmt.Errorf("S3 Directory Sync (%s) object count: got %d, expected %d", rs.Primary.ID, got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObject(n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
+
+		output, err := conn.HeadObject(&s3.HeadObjectInput{
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		if err != nil {
+			return fmt.Errorf("S3 Object (%s): %w", key, err)
+		}
+
+		if got := aws.StringValue(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type: got %q, expected %q", key, got, contentType)
+		}
+
+		if got := aws.StringValue(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) cache control: got %q, expected %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[1]q
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_fileRule(rName, source, cacheControl string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[1]q
+
+  file_rule {
+    pattern       = "**"
+    cache_control = %[2]q
+  }
+
+  file_rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+  }
+}
+`, source, cacheControl))
+}
+
+func testAccDirectorySyncConfig_deleteRemovedObjects(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket                 = aws_s3_bucket.test.bucket
+  key_prefix             = "site/"
+  source                 = %[1]q
+  delete_removed_objects = true
+}
+`, source))
+}
+
+func testAccDirectorySyncConfig_deleteRemovedObjectsNoKeyPrefix(rName, source string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncBaseConfig(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket                 = aws_s3_bucket.test.bucket
+  source                 = %[1]q
+  delete_removed_objects = true
+}
+`, source))
+}
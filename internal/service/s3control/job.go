@@ -0,0 +1,953 @@
+package s3control
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceJob() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceJobCreate,
+		Read:   resourceJobRead,
+		Update: resourceJobUpdate,
+		Delete: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"confirmation_required": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"wait_for_completion"},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"failure_reasons": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"failure_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"failure_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"manifest": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"etag": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"object_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"object_version_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(s3control.JobManifestFieldName_Values(), false),
+										},
+									},
+									"format": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.JobManifestFormat_Values(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"max_failed_tasks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				RequiredWith: []string{"wait_for_completion"},
+			},
+			"max_failed_tasks_percentage": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+				RequiredWith: []string{"wait_for_completion"},
+			},
+			"operation": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_invoke": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"function_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_initiate_restore_object": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expiration_in_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"glacier_job_tier": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3GlacierJobTier_Values(), false),
+									},
+								},
+							},
+						},
+						"s3_put_object_copy": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_key_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"canned_access_control_list": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3CannedAccessControlList_Values(), false),
+									},
+									"metadata_directive": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3MetadataDirective_Values(), false),
+									},
+									"requester_pays": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"sse_aws_kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(s3control.S3StorageClass_Values(), false),
+									},
+									"target_key_prefix": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"target_resource": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"s3_put_object_tagging": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: jobOperationKeys,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"tag_set": {
+										Type:     schema.TypeMap,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+			"progress_summary": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number_of_tasks_failed": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"number_of_tasks_succeeded": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"total_number_of_tasks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"report": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportFormat_Values(), false),
+						},
+						"prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"report_scope": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(s3control.JobReportScope_Values(), false),
+						},
+					},
+				},
+			},
+			"report_location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_update_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+var jobOperationKeys = []string{
+	"operation.0.lambda_invoke",
+	"operation.0.s3_initiate_restore_object",
+	"operation.0.s3_put_object_copy",
+	"operation.0.s3_put_object_tagging",
+}
+
+func resourceJobCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	input := &s3control.CreateJobInput{
+		AccountId:            aws.String(accountID),
+		ClientRequestToken:   aws.String(resource.UniqueId()),
+		ConfirmationRequired: aws.Bool(d.Get("confirmation_required").(bool)),
+		Priority:             aws.Int64(int64(d.Get("priority").(int))),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("manifest"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Manifest = expandJobManifest(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("operation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Operation = expandJobOperation(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("report"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Report = expandJobReport(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating S3 Batch Operations Job: %s", input)
+	output, err := conn.CreateJob(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Batch Operations Job: %w", err)
+	}
+
+	d.SetId(JobCreateResourceID(accountID, aws.StringValue(output.JobId)))
+
+	if d.Get("wait_for_completion").(bool) {
+		var maxFailedTasks *int64
+		var maxFailedTasksPercentage *float64
+
+		// A threshold of zero is meaningful, so check the raw configuration rather than using GetOk.
+		if !d.GetRawConfig().GetAttr("max_failed_tasks").IsNull() {
+			maxFailedTasks = aws.Int64(int64(d.Get("max_failed_tasks").(int)))
+		}
+
+		if !d.GetRawConfig().GetAttr("max_failed_tasks_percentage").IsNull() {
+			maxFailedTasksPercentage = aws.Float64(d.Get("max_failed_tasks_percentage").(float64))
+		}
+
+		if _, err := waitJobCompleted(conn, accountID, aws.StringValue(output.JobId), maxFailedTasks, maxFailedTasksPercentage, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if errors.Is(err, errJobFailureThresholdExceeded) {
+				log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+				_, cancelErr := conn.UpdateJobStatus(&s3control.UpdateJobStatusInput{
+					AccountId:          aws.String(accountID),
+					JobId:              output.JobId,
+					RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+					StatusUpdateReason: aws.String(err.Error()),
+				})
+
+				if cancelErr != nil && !tfawserr.ErrCodeEquals(cancelErr, s3control.ErrCodeJobStatusException) {
+					log.Printf("[WARN] Error cancelling S3 Batch Operations Job (%s): %s", d.Id(), cancelErr)
+				}
+			}
+
+			return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) to complete: %w", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] S3 Batch Operations Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("arn", job.JobArn)
+	d.Set("confirmation_required", job.ConfirmationRequired)
+	d.Set("description", job.Description)
+	if err := d.Set("failure_reasons", flattenJobFailures(job.FailureReasons)); err != nil {
+		return fmt.Errorf("error setting failure_reasons: %w", err)
+	}
+	d.Set("job_id", job.JobId)
+	if job.Manifest != nil {
+		if err := d.Set("manifest", []interface{}{flattenJobManifest(job.Manifest)}); err != nil {
+			return fmt.Errorf("error setting manifest: %w", err)
+		}
+	} else {
+		d.Set("manifest", nil)
+	}
+	if job.Operation != nil {
+		if err := d.Set("operation", []interface{}{flattenJobOperation(job.Operation)}); err != nil {
+			return fmt.Errorf("error setting operation: %w", err)
+		}
+	} else {
+		d.Set("operation", nil)
+	}
+	d.Set("priority", job.Priority)
+	if job.ProgressSummary != nil {
+		if err := d.Set("progress_summary", []interface{}{flattenJobProgressSummary(job.ProgressSummary)}); err != nil {
+			return fmt.Errorf("error setting progress_summary: %w", err)
+		}
+	} else {
+		d.Set("progress_summary", nil)
+	}
+	if job.Report != nil {
+		if err := d.Set("report", []interface{}{flattenJobReport(job.Report)}); err != nil {
+			return fmt.Errorf("error setting report: %w", err)
+		}
+	} else {
+		d.Set("report", nil)
+	}
+	d.Set("report_location", jobReportLocation(job))
+	d.Set("role_arn", job.RoleArn)
+	d.Set("status", job.Status)
+	d.Set("status_update_reason", job.StatusUpdateReason)
+
+	return nil
+}
+
+func resourceJobUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("priority") {
+		input := &s3control.UpdateJobPriorityInput{
+			AccountId: aws.String(accountID),
+			JobId:     aws.String(jobID),
+			Priority:  aws.Int64(int64(d.Get("priority").(int))),
+		}
+
+		log.Printf("[DEBUG] Updating S3 Batch Operations Job priority: %s", input)
+		_, err := conn.UpdateJobPriority(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating S3 Batch Operations Job (%s) priority: %w", d.Id(), err)
+		}
+	}
+
+	return resourceJobRead(d, meta)
+}
+
+func resourceJobDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ControlConn
+
+	accountID, jobID, err := JobParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	job, err := FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	// Jobs cannot be deleted. They are retained by S3 for 90 days after they finish.
+	if jobStatusIsTerminal(aws.StringValue(job.Status)) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling S3 Batch Operations Job: %s", d.Id())
+	_, err = conn.UpdateJobStatus(&s3control.UpdateJobStatusInput{
+		AccountId:          aws.String(accountID),
+		JobId:              aws.String(jobID),
+		RequestedJobStatus: aws.String(s3control.RequestedJobStatusCancelled),
+		StatusUpdateReason: aws.String("Cancelled by Terraform"),
+	})
+
+	// The job may have finished since it was described.
+	if tfawserr.ErrCodeEquals(err, s3control.ErrCodeJobStatusException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error cancelling S3 Batch Operations Job (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitJobFinished(conn, accountID, jobID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for S3 Batch Operations Job (%s) to cancel: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const jobResourceIDSeparator = ":"
+
+func JobCreateResourceID(accountID, jobID string) string {
+	parts := []string{accountID, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func JobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected account-id%[2]sjob-id", id, jobResourceIDSeparator)
+}
+
+func jobStatusIsTerminal(status string) bool {
+	switch status {
+	case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// jobReportLocation returns the S3 URI under which the job's completion report is written.
+func jobReportLocation(job *s3control.JobDescriptor) string {
+	if job.Report == nil || !aws.BoolValue(job.Report.Enabled) || job.Report.Bucket == nil {
+		return ""
+	}
+
+	bucketARN, err := arn.Parse(aws.StringValue(job.Report.Bucket))
+
+	if err != nil {
+		return ""
+	}
+
+	location := "s3://" + bucketARN.Resource + "/"
+
+	if v := aws.StringValue(job.Report.Prefix); v != "" {
+		location += strings.TrimSuffix(v, "/") + "/"
+	}
+
+	return location + "job-" + aws.StringValue(job.JobId) + "/"
+}
+
+func expandJobManifest(tfMap map[string]interface{}) *s3control.JobManifest {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifest{}
+
+	if v, ok := tfMap["location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Location = expandJobManifestLocation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["spec"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Spec = expandJobManifestSpec(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandJobManifestLocation(tfMap map[string]interface{}) *s3control.JobManifestLocation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestLocation{}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_arn"].(string); ok && v != "" {
+		apiObject.ObjectArn = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_version_id"].(string); ok && v != "" {
+		apiObject.ObjectVersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobManifestSpec(tfMap map[string]interface{}) *s3control.JobManifestSpec {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobManifestSpec{}
+
+	if v, ok := tfMap["fields"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Fields = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandJobOperation(tfMap map[string]interface{}) *s3control.JobOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobOperation{}
+
+	if v, ok := tfMap["lambda_invoke"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.LambdaInvoke = &s3control.LambdaInvokeOperation{}
+
+		if v, ok := tfMap["function_arn"].(string); ok && v != "" {
+			apiObject.LambdaInvoke.FunctionArn = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3_initiate_restore_object"].([]interface{}); ok && len(v) > 0 {
+		apiObject.S3InitiateRestoreObject = &s3control.S3InitiateRestoreObjectOperation{}
+
+		if v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["expiration_in_days"].(int); ok && v != 0 {
+				apiObject.S3InitiateRestoreObject.ExpirationInDays = aws.Int64(int64(v))
+			}
+
+			if v, ok := tfMap["glacier_job_tier"].(string); ok && v != "" {
+				apiObject.S3InitiateRestoreObject.GlacierJobTier = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["s3_put_object_copy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3PutObjectCopy = expandS3CopyObjectOperation(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["s3_put_object_tagging"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3PutObjectTagging = &s3control.S3SetObjectTaggingOperation{}
+
+		if v, ok := tfMap["tag_set"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.S3PutObjectTagging.TagSet = expandS3Tags(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandS3CopyObjectOperation(tfMap map[string]interface{}) *s3control.S3CopyObjectOperation {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.S3CopyObjectOperation{}
+
+	if v, ok := tfMap["bucket_key_enabled"].(bool); ok && v {
+		apiObject.BucketKeyEnabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["canned_access_control_list"].(string); ok && v != "" {
+		apiObject.CannedAccessControlList = aws.String(v)
+	}
+
+	if v, ok := tfMap["metadata_directive"].(string); ok && v != "" {
+		apiObject.MetadataDirective = aws.String(v)
+	}
+
+	if v, ok := tfMap["requester_pays"].(bool); ok && v {
+		apiObject.RequesterPays = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["sse_aws_kms_key_id"].(string); ok && v != "" {
+		apiObject.SSEAwsKmsKeyId = aws.String(v)
+	}
+
+	if v, ok := tfMap["storage_class"].(string); ok && v != "" {
+		apiObject.StorageClass = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_key_prefix"].(string); ok && v != "" {
+		apiObject.TargetKeyPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["target_resource"].(string); ok && v != "" {
+		apiObject.TargetResource = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Tags(tfMap map[string]interface{}) []*s3control.S3Tag {
+	var apiObjects []*s3control.S3Tag
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &s3control.S3Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandJobReport(tfMap map[string]interface{}) *s3control.JobReport {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3control.JobReport{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["enabled"].(bool); ok {
+		apiObject.Enabled = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = aws.String(v)
+	}
+
+	if v, ok := tfMap["prefix"].(string); ok && v != "" {
+		apiObject.Prefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["report_scope"].(string); ok && v != "" {
+		apiObject.ReportScope = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenJobFailures(apiObjects []*s3control.JobFailure) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"failure_code":   aws.StringValue(apiObject.FailureCode),
+			"failure_reason": aws.StringValue(apiObject.FailureReason),
+		})
+	}
+
+	return tfList
+}
+
+func flattenJobManifest(apiObject *s3control.JobManifest) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Location; v != nil {
+		tfMap["location"] = []interface{}{map[string]interface{}{
+			"etag":              aws.StringValue(v.ETag),
+			"object_arn":        aws.StringValue(v.ObjectArn),
+			"object_version_id": aws.StringValue(v.ObjectVersionId),
+		}}
+	}
+
+	if v := apiObject.Spec; v != nil {
+		tfMap["spec"] = []interface{}{map[string]interface{}{
+			"fields": aws.StringValueSlice(v.Fields),
+			"format": aws.StringValue(v.Format),
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobOperation(apiObject *s3control.JobOperation) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LambdaInvoke; v != nil {
+		tfMap["lambda_invoke"] = []interface{}{map[string]interface{}{
+			"function_arn": aws.StringValue(v.FunctionArn),
+		}}
+	}
+
+	if v := apiObject.S3InitiateRestoreObject; v != nil {
+		tfMap["s3_initiate_restore_object"] = []interface{}{map[string]interface{}{
+			"expiration_in_days": aws.Int64Value(v.ExpirationInDays),
+			"glacier_job_tier":   aws.StringValue(v.GlacierJobTier),
+		}}
+	}
+
+	if v := apiObject.S3PutObjectCopy; v != nil {
+		tfMap["s3_put_object_copy"] = []interface{}{map[string]interface{}{
+			"bucket_key_enabled":         aws.BoolValue(v.BucketKeyEnabled),
+			"canned_access_control_list": aws.StringValue(v.CannedAccessControlList),
+			"metadata_directive":         aws.StringValue(v.MetadataDirective),
+			"requester_pays":             aws.BoolValue(v.RequesterPays),
+			"sse_aws_kms_key_id":         aws.StringValue(v.SSEAwsKmsKeyId),
+			"storage_class":              aws.StringValue(v.StorageClass),
+			"target_key_prefix":          aws.StringValue(v.TargetKeyPrefix),
+			"target_resource":            aws.StringValue(v.TargetResource),
+		}}
+	}
+
+	if v := apiObject.S3PutObjectTagging; v != nil {
+		tagSet := make(map[string]interface{}, len(v.TagSet))
+
+		for _, tag := range v.TagSet {
+			tagSet[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+
+		tfMap["s3_put_object_tagging"] = []interface{}{map[string]interface{}{
+			"tag_set": tagSet,
+		}}
+	}
+
+	return tfMap
+}
+
+func flattenJobProgressSummary(apiObject *s3control.JobProgressSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"number_of_tasks_failed":    aws.Int64Value(apiObject.NumberOfTasksFailed),
+		"number_of_tasks_succeeded": aws.Int64Value(apiObject.NumberOfTasksSucceeded),
+		"total_number_of_tasks":     aws.Int64Value(apiObject.TotalNumberOfTasks),
+	}
+}
+
+func flattenJobReport(apiObject *s3control.JobReport) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"bucket":       aws.StringValue(apiObject.Bucket),
+		"enabled":      aws.BoolValue(apiObject.Enabled),
+		"format":       aws.StringValue(apiObject.Format),
+		"prefix":       aws.StringValue(apiObject.Prefix),
+		"report_scope": aws.StringValue(apiObject.ReportScope),
+	}
+}
@@ -0,0 +1,360 @@
+package s3control_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3control "github.com/hashicorp/terraform-provider-aws/internal/service/s3control"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccS3ControlJob_basic(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_required", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "manifest.0.spec.0.format", s3control.JobManifestFormatS3batchOperationsCsv20180820),
+					resource.TestCheckResourceAttr(resourceName, "operation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "operation.0.s3_put_object_tagging.0.tag_set.Processed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "report.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "report_location", ""),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccJobConfig_basic(rName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_waitForCompletion(t *testing.T) {
+	var v s3control.JobDescriptor
+	resourceName := "aws_s3control_job.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_waitForCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "status", s3control.JobStatusComplete),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_failed", "0"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.number_of_tasks_succeeded", "2"),
+					resource.TestCheckResourceAttr(resourceName, "progress_summary.0.total_number_of_tasks", "2"),
+					resource.TestCheckResourceAttr(resourceName, "report.0.enabled", "true"),
+					resource.TestMatchResourceAttr(resourceName, "report_location", regexp.MustCompile(fmt.Sprintf(`^s3://%s/reports/job-[0-9a-f-]+/$`, rName))),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_failed_tasks", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccS3ControlJob_maxFailedTasks(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3control.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_maxFailedTasks(rName),
+				ExpectError: regexp.MustCompile(`failed task threshold exceeded`),
+			},
+		},
+	})
+}
+
+func testAccCheckJobDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_s3control_job" {
+			continue
+		}
+
+		accountID, jobID, err := tfs3control.JobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// Jobs are retained after they finish, so check that the job is no longer running.
+		switch status := aws.StringValue(output.Status); status {
+		case s3control.JobStatusCancelled, s3control.JobStatusComplete, s3control.JobStatusFailed:
+			continue
+		default:
+			return fmt.Errorf("S3 Batch Operations Job %s still running (%s)", rs.Primary.ID, status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckJobExists(n string, v *s3control.JobDescriptor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No S3 Batch Operations Job ID is set")
+		}
+
+		accountID, jobID, err := tfs3control.JobParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3ControlConn
+
+		output, err := tfs3control.FindJobByAccountIDAndJobID(conn, accountID, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobBaseConfig(rName, manifest string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "object1" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/object1"
+  content = "object1"
+}
+
+resource "aws_s3_object" "object2" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "data/object2"
+  content = "object2"
+}
+
+resource "aws_s3_object" "manifest" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "manifest.csv"
+  content = %[2]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "batchoperations.s3.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:PutObject",
+        "s3:PutObjectTagging",
+        "s3:PutObjectVersionTagging",
+      ]
+      Effect   = "Allow"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+`, rName, manifest)
+}
+
+func testAccJobConfig_basic(rName string, priority int) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName, fmt.Sprintf("%[1]s,data/object1\n%[1]s,data/object2\n", rName)), fmt.Sprintf(`
+resource "aws_s3control_job" "test" {
+  confirmation_required = true
+  priority              = %[2]d
+  role_arn              = aws_iam_role.test.arn
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      format = "S3BatchOperations_CSV_20180820"
+      fields = ["Bucket", "Key"]
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, priority))
+}
+
+func testAccJobConfig_waitForCompletion(rName string) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName, fmt.Sprintf("%[1]s,data/object1\n%[1]s,data/object2\n", rName)), `
+resource "aws_s3control_job" "test" {
+  priority            = 10
+  role_arn            = aws_iam_role.test.arn
+  wait_for_completion = true
+  max_failed_tasks    = 0
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      format = "S3BatchOperations_CSV_20180820"
+      fields = ["Bucket", "Key"]
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled      = true
+    bucket       = aws_s3_bucket.test.arn
+    format       = "Report_CSV_20180820"
+    prefix       = "reports"
+    report_scope = "AllTasks"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccJobConfig_maxFailedTasks(rName string) string {
+	return acctest.ConfigCompose(testAccJobBaseConfig(rName, fmt.Sprintf("%[1]s,data/object1\n%[1]s,data/missing1\n%[1]s,data/missing2\n", rName)), `
+resource "aws_s3control_job" "test" {
+  priority                    = 10
+  role_arn                    = aws_iam_role.test.arn
+  wait_for_completion         = true
+  max_failed_tasks_percentage = 50
+
+  manifest {
+    location {
+      etag       = aws_s3_object.manifest.etag
+      object_arn = "${aws_s3_bucket.test.arn}/${aws_s3_object.manifest.key}"
+    }
+
+    spec {
+      format = "S3BatchOperations_CSV_20180820"
+      fields = ["Bucket", "Key"]
+    }
+  }
+
+  operation {
+    s3_put_object_tagging {
+      tag_set = {
+        Processed = "true"
+      }
+    }
+  }
+
+  report {
+    enabled = false
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
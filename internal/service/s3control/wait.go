@@ -1,8 +1,10 @@
 package s3control
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -24,8 +26,14 @@ const (
 	multiRegionAccessPointRequestSucceededMinTimeout = 5 * time.Second
 
 	multiRegionAccessPointRequestSucceededDelay = 15 * time.Second
+
+	jobStatusMinTimeout = 10 * time.Second
+
+	jobCancelledDelay = 5 * time.Second
 )
 
+var errJobFailureThresholdExceeded = errors.New("failed task threshold exceeded")
+
 func waitPublicAccessBlockConfigurationBlockPublicACLsUpdated(conn *s3control.S3Control, accountID string, expectedValue bool) (*s3control.PublicAccessBlockConfiguration, error) {
 	stateConf := &resource.StateChangeConf{
 		Target:                    []string{strconv.FormatBool(expectedValue)},
@@ -119,3 +127,122 @@ func waitMultiRegionAccessPointRequestSucceeded(conn *s3control.S3Control, accou
 
 	return nil, err
 }
+
+func waitJobCompleted(conn *s3control.S3Control, accountID string, jobID string, maxFailedTasks *int64, maxFailedTasksPercentage *float64, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	refresh := statusJob(conn, accountID, jobID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailing,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Target: []string{s3control.JobStatusComplete},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if err != nil || outputRaw == nil {
+				return outputRaw, status, err
+			}
+
+			if err := checkJobFailureThresholds(outputRaw.(*s3control.JobDescriptor), maxFailedTasks, maxFailedTasksPercentage); err != nil {
+				return outputRaw, status, err
+			}
+
+			return outputRaw, status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: jobStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		setJobLastError(err, output)
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobFinished(conn *s3control.S3Control, accountID string, jobID string, timeout time.Duration) (*s3control.JobDescriptor, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			s3control.JobStatusActive,
+			s3control.JobStatusCancelling,
+			s3control.JobStatusCompleting,
+			s3control.JobStatusFailing,
+			s3control.JobStatusNew,
+			s3control.JobStatusPaused,
+			s3control.JobStatusPausing,
+			s3control.JobStatusPreparing,
+			s3control.JobStatusReady,
+			s3control.JobStatusSuspended,
+		},
+		Target: []string{
+			s3control.JobStatusCancelled,
+			s3control.JobStatusComplete,
+			s3control.JobStatusFailed,
+		},
+		Refresh: statusJob(conn, accountID, jobID),
+		Timeout: timeout,
+		Delay:   jobCancelledDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*s3control.JobDescriptor); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// checkJobFailureThresholds returns an error wrapping errJobFailureThresholdExceeded
+// if the job's failed task count exceeds either of the specified thresholds.
+func checkJobFailureThresholds(job *s3control.JobDescriptor, maxFailedTasks *int64, maxFailedTasksPercentage *float64) error {
+	if job.ProgressSummary == nil {
+		return nil
+	}
+
+	failed := aws.Int64Value(job.ProgressSummary.NumberOfTasksFailed)
+
+	if maxFailedTasks != nil && failed > aws.Int64Value(maxFailedTasks) {
+		return fmt.Errorf("%w: %d tasks failed (maximum %d)", errJobFailureThresholdExceeded, failed, aws.Int64Value(maxFailedTasks))
+	}
+
+	if total := aws.Int64Value(job.ProgressSummary.TotalNumberOfTasks); maxFailedTasksPercentage != nil && total > 0 {
+		if percentage := float64(failed) * 100 / float64(total); percentage > aws.Float64Value(maxFailedTasksPercentage) {
+			return fmt.Errorf("%w: %.2f%% of tasks failed (maximum %.2f%%)", errJobFailureThresholdExceeded, percentage, aws.Float64Value(maxFailedTasksPercentage))
+		}
+	}
+
+	return nil
+}
+
+func setJobLastError(err error, job *s3control.JobDescriptor) {
+	if status := aws.StringValue(job.Status); status != s3control.JobStatusFailed && status != s3control.JobStatusCancelled {
+		return
+	}
+
+	var errs []string
+
+	for _, v := range job.FailureReasons {
+		errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(v.FailureCode), aws.StringValue(v.FailureReason)))
+	}
+
+	if v := aws.StringValue(job.StatusUpdateReason); v != "" {
+		errs = append(errs, v)
+	}
+
+	if len(errs) > 0 {
+		tfresource.SetLastError(err, errors.New(strings.Join(errs, "; ")))
+	}
+}
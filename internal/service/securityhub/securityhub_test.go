@@ -19,6 +19,12 @@ func TestAccSecurityHub_serial(t *testing.T) {
 			"Description": testAccActionTarget_Description,
 			"Name":        testAccActionTarget_Name,
 		},
+		"AutomationRule": {
+			"basic":      testAccAutomationRule_basic,
+			"disappears": testAccAutomationRule_disappears,
+			"tags":       testAccAutomationRule_tags,
+			"update":     testAccAutomationRule_update,
+		},
 		"Insight": {
 			"basic":            testAccInsight_basic,
 			"disappears":       testAccInsight_disappears,
@@ -0,0 +1,431 @@
+package sfn
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Amazon States Language state types.
+const (
+	aslStateTypeChoice   = "Choice"
+	aslStateTypeFail     = "Fail"
+	aslStateTypeMap      = "Map"
+	aslStateTypeParallel = "Parallel"
+	aslStateTypePass     = "Pass"
+	aslStateTypeSucceed  = "Succeed"
+	aslStateTypeTask     = "Task"
+	aslStateTypeWait     = "Wait"
+)
+
+const (
+	aslErrorStatesAll     = "States.ALL"
+	aslStateNameMaxLength = 80
+)
+
+// validateStateMachineDefinition performs an offline structural validation of an Amazon States Language definition.
+// It checks that every state machine, including Parallel branches and Map item processors, has a valid start state,
+// that every transition references a state in the same scope, that there is a terminal state and that every state is reachable.
+// Retry and Catch blocks are checked for their required fields and the placement of States.ALL.
+// Fields that are not needed for these checks, e.g. input and output processing, are not validated.
+func validateStateMachineDefinition(definition string) []error {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(definition), &v); err != nil {
+		return []error{fmt.Errorf("invalid JSON: %w", err)}
+	}
+
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return []error{fmt.Errorf("definition must be a JSON object")}
+	}
+
+	return validateASLStateMachine("", m)
+}
+
+func validateASLStateMachine(path string, m map[string]interface{}) []error {
+	var errs []error
+
+	startAt, ok := m["StartAt"].(string)
+
+	if !ok || startAt == "" {
+		errs = append(errs, fmt.Errorf("%s: StartAt is required", aslPath(path, "StartAt")))
+	}
+
+	states, ok := m["States"].(map[string]interface{})
+
+	if !ok || len(states) == 0 {
+		return append(errs, fmt.Errorf("%s: at least one state is required", aslPath(path, "States")))
+	}
+
+	if startAt != "" {
+		if _, ok := states[startAt]; !ok {
+			errs = append(errs, fmt.Errorf("%s: state %q does not exist", aslPath(path, "StartAt"), startAt))
+		}
+	}
+
+	names := make([]string, 0, len(states))
+
+	for name := range states {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	transitions := make(map[string][]string)
+	terminal := false
+
+	for _, name := range names {
+		statePath := aslPath(path, "States."+name)
+		state, ok := states[name].(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: state must be a JSON object", statePath))
+			continue
+		}
+
+		if len(name) > aslStateNameMaxLength {
+			errs = append(errs, fmt.Errorf("%s: state name cannot be longer than %d characters", statePath, aslStateNameMaxLength))
+		}
+
+		next, isTerminal, stateErrs := validateASLState(statePath, state)
+
+		errs = append(errs, stateErrs...)
+
+		for _, n := range next {
+			if _, ok := states[n.name]; !ok {
+				errs = append(errs, fmt.Errorf("%s: state %q does not exist", aslPath(statePath, n.field), n.name))
+				continue
+			}
+
+			transitions[name] = append(transitions[name], n.name)
+		}
+
+		if isTerminal {
+			terminal = true
+		}
+	}
+
+	if !terminal {
+		errs = append(errs, fmt.Errorf("%s: no terminal state, at least one state must be of type Succeed or Fail or set End to true", aslPath(path, "States")))
+	}
+
+	if _, ok := states[startAt]; ok {
+		reachable := map[string]bool{startAt: true}
+		queue := []string{startAt}
+
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+
+			for _, n := range transitions[name] {
+				if !reachable[n] {
+					reachable[n] = true
+					queue = append(queue, n)
+				}
+			}
+		}
+
+		for _, name := range names {
+			if !reachable[name] {
+				errs = append(errs, fmt.Errorf("%s: state is not reachable from %q", aslPath(path, "States."+name), startAt))
+			}
+		}
+	}
+
+	return errs
+}
+
+type aslTransition struct {
+	field string
+	name  string
+}
+
+// validateASLState validates a single state, returning the state's transitions and whether it is a terminal state.
+func validateASLState(path string, state map[string]interface{}) ([]aslTransition, bool, []error) {
+	var errs []error
+	var next []aslTransition
+	terminal := false
+
+	stateType, _ := state["Type"].(string)
+
+	switch stateType {
+	case aslStateTypeTask, aslStateTypePass, aslStateTypeWait, aslStateTypeParallel, aslStateTypeMap:
+		n, end, nextErrs := validateASLNextOrEnd(path, state)
+
+		errs = append(errs, nextErrs...)
+		next = append(next, n...)
+		terminal = end
+
+	case aslStateTypeChoice, aslStateTypeSucceed, aslStateTypeFail:
+		for _, field := range []string{"Next", "End"} {
+			if _, ok := state[field]; ok {
+				errs = append(errs, fmt.Errorf("%s: %s is not supported for %s states", aslPath(path, field), field, stateType))
+			}
+		}
+
+		terminal = stateType != aslStateTypeChoice
+
+	case "":
+		return nil, false, []error{fmt.Errorf("%s: Type is required", aslPath(path, "Type"))}
+
+	default:
+		return nil, false, []error{fmt.Errorf("%s: unsupported state type %q", aslPath(path, "Type"), stateType)}
+	}
+
+	switch stateType {
+	case aslStateTypeTask:
+		if v, ok := state["Resource"].(string); !ok || v == "" {
+			errs = append(errs, fmt.Errorf("%s: Resource is required", aslPath(path, "Resource")))
+		}
+
+	case aslStateTypeWait:
+		n := 0
+
+		for _, field := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[field]; ok {
+				n++
+			}
+		}
+
+		if n != 1 {
+			errs = append(errs, fmt.Errorf("%s: exactly one of Seconds, SecondsPath, Timestamp or TimestampPath is required", path))
+		}
+
+	case aslStateTypeChoice:
+		choices, ok := state["Choices"].([]interface{})
+
+		if !ok || len(choices) == 0 {
+			errs = append(errs, fmt.Errorf("%s: at least one choice rule is required", aslPath(path, "Choices")))
+		}
+
+		for i, v := range choices {
+			choicePath := fmt.Sprintf("%s[%d]", aslPath(path, "Choices"), i)
+			choice, ok := v.(map[string]interface{})
+
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: choice rule must be a JSON object", choicePath))
+				continue
+			}
+
+			if v, ok := choice["Next"].(string); ok && v != "" {
+				next = append(next, aslTransition{field: fmt.Sprintf("Choices[%d].Next", i), name: v})
+			} else {
+				errs = append(errs, fmt.Errorf("%s: Next is required", aslPath(choicePath, "Next")))
+			}
+		}
+
+		if v, ok := state["Default"]; ok {
+			if v, ok := v.(string); ok && v != "" {
+				next = append(next, aslTransition{field: "Default", name: v})
+			} else {
+				errs = append(errs, fmt.Errorf("%s: Default must be a state name", aslPath(path, "Default")))
+			}
+		}
+
+	case aslStateTypeParallel:
+		branches, ok := state["Branches"].([]interface{})
+
+		if !ok || len(branches) == 0 {
+			errs = append(errs, fmt.Errorf("%s: at least one branch is required", aslPath(path, "Branches")))
+		}
+
+		for i, v := range branches {
+			branchPath := fmt.Sprintf("%s[%d]", aslPath(path, "Branches"), i)
+			branch, ok := v.(map[string]interface{})
+
+			if !ok {
+				errs = append(errs, fmt.Errorf("%s: branch must be a JSON object", branchPath))
+				continue
+			}
+
+			errs = append(errs, validateASLStateMachine(branchPath, branch)...)
+		}
+
+	case aslStateTypeMap:
+		// Iterator is the deprecated name of ItemProcessor.
+		field := "ItemProcessor"
+		v, ok := state[field]
+
+		if !ok {
+			field = "Iterator"
+			v, ok = state[field]
+		}
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: ItemProcessor is required", aslPath(path, "ItemProcessor")))
+			break
+		}
+
+		processor, ok := v.(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s must be a JSON object", aslPath(path, field), field))
+			break
+		}
+
+		errs = append(errs, validateASLStateMachine(aslPath(path, field), processor)...)
+	}
+
+	for _, field := range []string{"Retry", "Catch"} {
+		v, ok := state[field]
+
+		if !ok {
+			continue
+		}
+
+		switch stateType {
+		case aslStateTypeTask, aslStateTypeParallel, aslStateTypeMap:
+		default:
+			errs = append(errs, fmt.Errorf("%s: %s is not supported for %s states", aslPath(path, field), field, stateType))
+			continue
+		}
+
+		list, ok := v.([]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s must be a JSON array", aslPath(path, field), field))
+			continue
+		}
+
+		if field == "Retry" {
+			errs = append(errs, validateASLRetriers(aslPath(path, field), list)...)
+		} else {
+			n, catchErrs := validateASLCatchers(aslPath(path, field), list)
+
+			errs = append(errs, catchErrs...)
+			next = append(next, n...)
+		}
+	}
+
+	return next, terminal, errs
+}
+
+func validateASLNextOrEnd(path string, state map[string]interface{}) ([]aslTransition, bool, []error) {
+	v, hasNext := state["Next"]
+	end, _ := state["End"].(bool)
+
+	if hasNext && end {
+		return nil, false, []error{fmt.Errorf("%s: only one of Next or End can be set", path)}
+	}
+
+	if !hasNext {
+		if !end {
+			return nil, false, []error{fmt.Errorf("%s: one of Next or End (true) is required", path)}
+		}
+
+		return nil, true, nil
+	}
+
+	if next, ok := v.(string); ok && next != "" {
+		return []aslTransition{{field: "Next", name: next}}, false, nil
+	}
+
+	return nil, false, []error{fmt.Errorf("%s: Next must be a state name", aslPath(path, "Next"))}
+}
+
+func validateASLRetriers(path string, retriers []interface{}) []error {
+	var errs []error
+
+	for i, v := range retriers {
+		retrierPath := fmt.Sprintf("%s[%d]", path, i)
+		retrier, ok := v.(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: retrier must be a JSON object", retrierPath))
+			continue
+		}
+
+		errs = append(errs, validateASLErrorEquals(retrierPath, retrier, i == len(retriers)-1)...)
+
+		if v, ok := retrier["IntervalSeconds"]; ok {
+			if v, ok := v.(float64); !ok || v < 1 || v != float64(int64(v)) {
+				errs = append(errs, fmt.Errorf("%s: IntervalSeconds must be a positive integer", aslPath(retrierPath, "IntervalSeconds")))
+			}
+		}
+
+		if v, ok := retrier["MaxAttempts"]; ok {
+			if v, ok := v.(float64); !ok || v < 0 || v != float64(int64(v)) {
+				errs = append(errs, fmt.Errorf("%s: MaxAttempts must be a non-negative integer", aslPath(retrierPath, "MaxAttempts")))
+			}
+		}
+
+		if v, ok := retrier["BackoffRate"]; ok {
+			if v, ok := v.(float64); !ok || v < 1 {
+				errs = append(errs, fmt.Errorf("%s: BackoffRate must be a number greater than or equal to 1.0", aslPath(retrierPath, "BackoffRate")))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateASLCatchers(path string, catchers []interface{}) ([]aslTransition, []error) {
+	var errs []error
+	var next []aslTransition
+
+	for i, v := range catchers {
+		catcherPath := fmt.Sprintf("%s[%d]", path, i)
+		catcher, ok := v.(map[string]interface{})
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: catcher must be a JSON object", catcherPath))
+			continue
+		}
+
+		errs = append(errs, validateASLErrorEquals(catcherPath, catcher, i == len(catchers)-1)...)
+
+		if v, ok := catcher["Next"].(string); ok && v != "" {
+			next = append(next, aslTransition{field: fmt.Sprintf("Catch[%d].Next", i), name: v})
+		} else {
+			errs = append(errs, fmt.Errorf("%s: Next is required", aslPath(catcherPath, "Next")))
+		}
+	}
+
+	return next, errs
+}
+
+// validateASLErrorEquals validates the ErrorEquals field of a retrier or catcher.
+// States.ALL must appear alone and only in the last retrier or catcher.
+func validateASLErrorEquals(path string, m map[string]interface{}, last bool) []error {
+	path = aslPath(path, "ErrorEquals")
+	errorEquals, ok := m["ErrorEquals"].([]interface{})
+
+	if !ok || len(errorEquals) == 0 {
+		return []error{fmt.Errorf("%s: at least one error name is required", path)}
+	}
+
+	var errs []error
+
+	for _, v := range errorEquals {
+		name, ok := v.(string)
+
+		if !ok || name == "" {
+			errs = append(errs, fmt.Errorf("%s: error names must be non-empty strings", path))
+			continue
+		}
+
+		if name != aslErrorStatesAll {
+			continue
+		}
+
+		if len(errorEquals) > 1 {
+			errs = append(errs, fmt.Errorf("%s: %s must appear alone", path, aslErrorStatesAll))
+		}
+
+		if !last {
+			errs = append(errs, fmt.Errorf("%s: %s must only appear in the last element", path, aslErrorStatesAll))
+		}
+	}
+
+	return errs
+}
+
+func aslPath(parent, elem string) string {
+	if parent == "" {
+		return elem
+	}
+
+	return parent + "." + elem
+}
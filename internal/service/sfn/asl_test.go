@@ -0,0 +1,261 @@
+package sfn
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestValidateStateMachineDefinition(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Definition   string
+		ExpectErrors []*regexp.Regexp
+	}{
+		{
+			Name: "task",
+			//lintignore:AWSAT005
+			Definition: `{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Retry": [
+        {"ErrorEquals": ["Lambda.ServiceException"], "IntervalSeconds": 2, "MaxAttempts": 0, "BackoffRate": 1.5},
+        {"ErrorEquals": ["States.ALL"], "IntervalSeconds": 5, "MaxAttempts": 5, "BackoffRate": 8}
+      ],
+      "Catch": [
+        {"ErrorEquals": ["States.ALL"], "Next": "Failed"}
+      ],
+      "End": true
+    },
+    "Failed": {
+      "Type": "Fail"
+    }
+  }
+}`,
+		},
+		{
+			Name: "choice parallel and map",
+			Definition: `{
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.parallel", "BooleanEquals": true, "Next": "Parallel"}
+      ],
+      "Default": "Map"
+    },
+    "Parallel": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "Wait", "States": {"Wait": {"Type": "Wait", "Seconds": 1, "End": true}}},
+        {"StartAt": "Pass", "States": {"Pass": {"Type": "Pass", "End": true}}}
+      ],
+      "Next": "Done"
+    },
+    "Map": {
+      "Type": "Map",
+      "ItemProcessor": {"StartAt": "Item", "States": {"Item": {"Type": "Succeed"}}},
+      "Next": "Done"
+    },
+    "Done": {
+      "Type": "Succeed"
+    }
+  }
+}`,
+		},
+		{
+			Name: "map iterator",
+			Definition: `{
+  "StartAt": "Map",
+  "States": {
+    "Map": {
+      "Type": "Map",
+      "Iterator": {"StartAt": "Item", "States": {"Item": {"Type": "Pass", "End": true}}},
+      "End": true
+    }
+  }
+}`,
+		},
+		{
+			Name:         "invalid json",
+			Definition:   `{`,
+			ExpectErrors: []*regexp.Regexp{regexp.MustCompile(`invalid JSON`)},
+		},
+		{
+			Name:       "missing start and states",
+			Definition: `{}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^StartAt: StartAt is required$`),
+				regexp.MustCompile(`^States: at least one state is required$`),
+			},
+		},
+		{
+			Name:       "unknown start state",
+			Definition: `{"StartAt": "Nope", "States": {"Done": {"Type": "Succeed"}}}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^StartAt: state "Nope" does not exist$`),
+			},
+		},
+		{
+			Name: "unknown next state",
+			Definition: `{
+  "StartAt": "Pass",
+  "States": {
+    "Pass": {"Type": "Pass", "Next": "Nope"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States.Pass.Next: state "Nope" does not exist$`),
+				regexp.MustCompile(`^States.Done: state is not reachable from "Pass"$`),
+			},
+		},
+		{
+			Name: "no terminal state",
+			Definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "B"},
+    "B": {"Type": "Pass", "Next": "A"}
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States: no terminal state`),
+			},
+		},
+		{
+			Name: "next and end",
+			Definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "B", "End": true},
+    "B": {"Type": "Pass"},
+    "C": {"Type": "Succeed", "Next": "A"}
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States.A: only one of Next or End can be set$`),
+				regexp.MustCompile(`^States.B: one of Next or End \(true\) is required$`),
+				regexp.MustCompile(`^States.C.Next: Next is not supported for Succeed states$`),
+				regexp.MustCompile(`^States.B: state is not reachable from "A"$`),
+				regexp.MustCompile(`^States.C: state is not reachable from "A"$`),
+			},
+		},
+		{
+			Name: "invalid state types",
+			Definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Lambda", "End": true},
+    "B": {"End": true}
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States.A.Type: unsupported state type "Lambda"$`),
+				regexp.MustCompile(`^States.B.Type: Type is required$`),
+				regexp.MustCompile(`^States: no terminal state`),
+				regexp.MustCompile(`^States.B: state is not reachable from "A"$`),
+			},
+		},
+		{
+			Name: "invalid retry and catch",
+			Definition: `{
+  "StartAt": "Task",
+  "States": {
+    "Task": {
+      "Type": "Task",
+      "Retry": [
+        {"ErrorEquals": ["States.ALL", "States.Timeout"]},
+        {"ErrorEquals": [], "IntervalSeconds": 0, "MaxAttempts": -1, "BackoffRate": 0.5}
+      ],
+      "Catch": [
+        {"ErrorEquals": ["States.ALL"]}
+      ],
+      "End": true
+    },
+    "Pass": {
+      "Type": "Pass",
+      "Retry": [],
+      "End": true
+    }
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States.Pass.Retry: Retry is not supported for Pass states$`),
+				regexp.MustCompile(`^States.Task.Resource: Resource is required$`),
+				regexp.MustCompile(`^States.Task.Retry\[0\].ErrorEquals: States.ALL must appear alone$`),
+				regexp.MustCompile(`^States.Task.Retry\[0\].ErrorEquals: States.ALL must only appear in the last element$`),
+				regexp.MustCompile(`^States.Task.Retry\[1\].ErrorEquals: at least one error name is required$`),
+				regexp.MustCompile(`^States.Task.Retry\[1\].IntervalSeconds: IntervalSeconds must be a positive integer$`),
+				regexp.MustCompile(`^States.Task.Retry\[1\].MaxAttempts: MaxAttempts must be a non-negative integer$`),
+				regexp.MustCompile(`^States.Task.Retry\[1\].BackoffRate: BackoffRate must be a number greater than or equal to 1.0$`),
+				regexp.MustCompile(`^States.Task.Catch\[0\].Next: Next is required$`),
+				regexp.MustCompile(`^States.Pass: state is not reachable from "Task"$`),
+			},
+		},
+		{
+			Name: "invalid branch",
+			Definition: `{
+  "StartAt": "Parallel",
+  "States": {
+    "Parallel": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "Wait", "States": {"Wait": {"Type": "Wait", "Next": "Done"}}}
+      ],
+      "Next": "Done"
+    },
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States.Parallel.Branches\[0\].States.Wait: exactly one of Seconds, SecondsPath, Timestamp or TimestampPath is required$`),
+				regexp.MustCompile(`^States.Parallel.Branches\[0\].States.Wait.Next: state "Done" does not exist$`),
+				regexp.MustCompile(`^States.Parallel.Branches\[0\].States: no terminal state`),
+			},
+		},
+		{
+			Name: "invalid choice",
+			Definition: `{
+  "StartAt": "Choose",
+  "States": {
+    "Choose": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.a", "IsPresent": true}
+      ],
+      "Default": "Nope",
+      "End": true
+    },
+    "Map": {"Type": "Map", "End": true}
+  }
+}`,
+			ExpectErrors: []*regexp.Regexp{
+				regexp.MustCompile(`^States.Choose.End: End is not supported for Choice states$`),
+				regexp.MustCompile(`^States.Choose.Choices\[0\].Next: Next is required$`),
+				regexp.MustCompile(`^States.Choose.Default: state "Nope" does not exist$`),
+				regexp.MustCompile(`^States.Map.ItemProcessor: ItemProcessor is required$`),
+				regexp.MustCompile(`^States.Map: state is not reachable from "Choose"$`),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			errs := validateStateMachineDefinition(testCase.Definition)
+
+			if len(errs) != len(testCase.ExpectErrors) {
+				t.Fatalf("expected %d errors, got %d: %v", len(testCase.ExpectErrors), len(errs), errs)
+			}
+
+			for i, err := range errs {
+				if !testCase.ExpectErrors[i].MatchString(err.Error()) {
+					t.Errorf("expected error %d to match %q, got %q", i, testCase.ExpectErrors[i], err)
+				}
+			}
+		})
+	}
+}
@@ -34,3 +34,31 @@ func FindStateMachineByARN(conn *sfn.SFN, arn string) (*sfn.DescribeStateMachine
 
 	return output, nil
 }
+
+func FindAliasByARN(conn *sfn.SFN, arn string) (*sfn.DescribeStateMachineAliasOutput, error) {
+	input := &sfn.DescribeStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeStateMachineAlias(input)
+
+	if tfawserr.ErrCodeEquals(err, sfn.ErrCodeResourceNotFound) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
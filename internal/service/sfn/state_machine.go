@@ -1,6 +1,7 @@
 package sfn
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -8,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sfn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -39,9 +41,12 @@ func ResourceStateMachine() *schema.Resource {
 			},
 
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024*1024), // 1048576
+					validStateMachineDefinition,
+				),
 			},
 
 			"logging_configuration": {
@@ -76,12 +81,28 @@ func ResourceStateMachine() *schema.Resource {
 				ValidateFunc: validStateMachineName,
 			},
 
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: verify.ValidARN,
 			},
 
+			"state_machine_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -113,9 +134,20 @@ func ResourceStateMachine() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customdiff.ComputedIf("state_machine_version_arn", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.Get("publish").(bool) && diff.HasChanges("definition", "logging_configuration", "publish", "role_arn", "tracing_configuration")
+			}),
+		),
 	}
 }
 
@@ -133,6 +165,14 @@ func resourceStateMachineCreate(d *schema.ResourceData, meta interface{}) error
 		Type:       aws.String(d.Get("type").(string)),
 	}
 
+	if d.Get("publish").(bool) {
+		input.Publish = aws.Bool(true)
+
+		if v, ok := d.GetOk("version_description"); ok {
+			input.VersionDescription = aws.String(v.(string))
+		}
+	}
+
 	if v, ok := d.GetOk("logging_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.LoggingConfiguration = expandSfnLoggingConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -177,6 +217,7 @@ func resourceStateMachineCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.SetId(aws.StringValue(output.StateMachineArn))
+	d.Set("state_machine_version_arn", output.StateMachineVersionArn)
 
 	return resourceStateMachineRead(d, meta)
 }
@@ -206,6 +247,7 @@ func resourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("definition", output.Definition)
 	d.Set("name", output.Name)
+	d.Set("revision_id", output.RevisionId)
 	d.Set("role_arn", output.RoleArn)
 	d.Set("type", output.Type)
 	d.Set("status", output.Status)
@@ -253,7 +295,7 @@ func resourceStateMachineRead(d *schema.ResourceData, meta interface{}) error {
 func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SFNConn
 
-	if d.HasChangesExcept("tags", "tags_all") {
+	if d.HasChangesExcept("tags", "tags_all", "version_description") {
 		// "You must include at least one of definition or roleArn or you will receive a MissingRequiredParameter error"
 		input := &sfn.UpdateStateMachineInput{
 			StateMachineArn: aws.String(d.Id()),
@@ -273,13 +315,25 @@ func resourceStateMachineUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 
+		if d.Get("publish").(bool) {
+			input.Publish = aws.Bool(true)
+
+			if v, ok := d.GetOk("version_description"); ok {
+				input.VersionDescription = aws.String(v.(string))
+			}
+		}
+
 		log.Printf("[DEBUG] Updating Step Function State Machine: %s", input)
-		_, err := conn.UpdateStateMachine(input)
+		output, err := conn.UpdateStateMachine(input)
 
 		if err != nil {
 			return fmt.Errorf("error updating Step Function State Machine (%s): %w", d.Id(), err)
 		}
 
+		if output.StateMachineVersionArn != nil {
+			d.Set("state_machine_version_arn", output.StateMachineVersionArn)
+		}
+
 		// Handle eventual consistency after update.
 		err = resource.Retry(stateMachineUpdatedTimeout, func() *resource.RetryError {
 			output, err := FindStateMachineByARN(conn, d.Id())
@@ -303,6 +303,63 @@ func TestAccSFNStateMachine_expressLogging(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_publish(t *testing.T) {
+	var sm sfn.DescribeStateMachineOutput
+	resourceName := "aws_sfn_state_machine.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachinePublishConfig(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestCheckResourceAttr(resourceName, "publish", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision_id"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "MaxAttempts 5"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "state_machine_version_arn", "version_description"},
+			},
+			{
+				Config: testAccStateMachinePublishConfig(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExists(resourceName, &sm),
+					resource.TestMatchResourceAttr(resourceName, "definition", regexp.MustCompile(`.*\"MaxAttempts\": 10.*`)),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "state_machine_version_arn", "states", fmt.Sprintf("stateMachine:%s:2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "version_description", "MaxAttempts 10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSFNStateMachine_invalidDefinition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sfn.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckStateMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineInvalidDefinitionConfig(rName),
+				ExpectError: regexp.MustCompile(`States.HelloWorld.Next: state "Goodbye" does not exist`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -709,3 +766,63 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachinePublishConfig(rName string, rMaxAttempts int) string {
+	return acctest.ConfigCompose(testAccStateMachineBaseConfig(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name                = %[1]q
+  role_arn            = aws_iam_role.for_sfn.arn
+  publish             = true
+  version_description = "MaxAttempts %[2]d"
+
+  definition = <<EOF
+{
+  "Comment": "A Hello World example of the Amazon States Language using an AWS Lambda Function",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "${aws_lambda_function.test.arn}",
+      "Retry": [
+        {
+          "ErrorEquals": [
+            "States.ALL"
+          ],
+          "IntervalSeconds": 5,
+          "MaxAttempts": %[2]d,
+          "BackoffRate": 8
+        }
+      ],
+      "End": true
+    }
+  }
+}
+EOF
+}
+`, rName, rMaxAttempts))
+}
+
+func testAccStateMachineInvalidDefinitionConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:role/%[1]s"
+
+  definition = <<EOF
+{
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Pass",
+      "Next": "Goodbye"
+    }
+  }
+}
+EOF
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
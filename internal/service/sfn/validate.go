@@ -17,3 +17,10 @@ func validStateMachineName(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+func validStateMachineDefinition(v interface{}, k string) (ws []string, errors []error) {
+	for _, err := range validateStateMachineDefinition(v.(string)) {
+		errors = append(errors, fmt.Errorf("%q is not a valid Amazon States Language definition: %w", k, err))
+	}
+	return
+}
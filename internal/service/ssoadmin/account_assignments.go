@@ -0,0 +1,685 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	accountAssignmentsDefaultConcurrency = 5
+	accountAssignmentsRetryTimeout       = 5 * time.Minute
+)
+
+func ResourceAccountAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountAssignmentsCreate,
+		Read:   resourceAccountAssignmentsRead,
+		Update: resourceAccountAssignmentsUpdate,
+		Delete: resourceAccountAssignmentsDelete,
+
+		CustomizeDiff: resourceAccountAssignmentsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"account_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"assignment": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"permission_set_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      accountAssignmentsDefaultConcurrency,
+				ValidateFunc: validation.IntBetween(1, 20),
+			},
+
+			"permission_set_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+
+			"principal": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"principal_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 47),
+								validation.StringMatch(regexp.MustCompile(`^([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}$`), "must match ([0-9a-f]{10}-|)[A-Fa-f0-9]{8}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{4}-[A-Fa-f0-9]{12}"),
+							),
+						},
+						"principal_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(ssoadmin.PrincipalType_Values(), false),
+						},
+					},
+				},
+			},
+
+			"target_account_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidAccountID,
+				},
+				AtLeastOneOf: []string{"target_account_ids", "target_organizational_unit_ids"},
+			},
+
+			"target_organizational_unit_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^(r-[0-9a-z]{4,32})|(ou-[0-9a-z]{4,32}-[a-z0-9]{8,32})$"), "see https://docs.aws.amazon.com/organizations/latest/APIReference/API_ListAccountsForParent.html#organizations-ListAccountsForParent-request-ParentId"),
+				},
+				AtLeastOneOf: []string{"target_account_ids", "target_organizational_unit_ids"},
+			},
+		},
+	}
+}
+
+func resourceAccountAssignmentsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	accountIDs, err := findAccountAssignmentsTargetAccountIDs(meta.(*conns.AWSClient).OrganizationsConn, d.Get("target_account_ids").(*schema.Set), d.Get("target_organizational_unit_ids").(*schema.Set))
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Account Assignments: %w", err)
+	}
+
+	permissionSetArns := aws.StringValueSlice(flex.ExpandStringSet(d.Get("permission_set_arns").(*schema.Set)))
+	principals := expandAccountAssignmentsPrincipals(d.Get("principal").(*schema.Set).List())
+
+	// The resource takes exclusive ownership of its assignments, so assignments that already exist are not adopted.
+	existing, err := findAccountAssignmentsInScope(conn, instanceArn, principals, permissionSetArns, accountIDs)
+
+	if err != nil {
+		return fmt.Errorf("error creating SSO Account Assignments: %w", err)
+	}
+
+	if len(existing) > 0 {
+		return fmt.Errorf("error creating SSO Account Assignments: %w", accountAssignmentsExistError(existing))
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("account_ids", accountIDs)
+
+	desired := accountAssignmentsMatrix(principals, permissionSetArns, accountIDs)
+
+	if err := updateAccountAssignments(conn, instanceArn, nil, desired, d.Get("max_concurrency").(int)); err != nil {
+		return fmt.Errorf("error creating SSO Account Assignments (%s): %w", d.Id(), err)
+	}
+
+	return resourceAccountAssignmentsRead(d, meta)
+}
+
+func resourceAccountAssignmentsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArns := aws.StringValueSlice(flex.ExpandStringSet(d.Get("permission_set_arns").(*schema.Set)))
+	principals := expandAccountAssignmentsPrincipals(d.Get("principal").(*schema.Set).List())
+	accountIDs := aws.StringValueSlice(flex.ExpandStringSet(d.Get("account_ids").(*schema.Set)))
+
+	assignments, err := findAccountAssignmentsInScope(conn, instanceArn, principals, permissionSetArns, accountIDs)
+
+	if err != nil {
+		return fmt.Errorf("error reading SSO Account Assignments (%s): %w", d.Id(), err)
+	}
+
+	if err := d.Set("assignment", flattenAccountAssignmentKeys(assignments)); err != nil {
+		return fmt.Errorf("error setting assignment: %w", err)
+	}
+
+	return nil
+}
+
+func resourceAccountAssignmentsUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+
+	// The targets may not have been known at plan time, so they are resolved again here.
+	accountIDs, err := findAccountAssignmentsTargetAccountIDs(meta.(*conns.AWSClient).OrganizationsConn, d.Get("target_account_ids").(*schema.Set), d.Get("target_organizational_unit_ids").(*schema.Set))
+
+	if err != nil {
+		return fmt.Errorf("error updating SSO Account Assignments (%s): %w", d.Id(), err)
+	}
+
+	d.Set("account_ids", accountIDs)
+
+	permissionSetArns := aws.StringValueSlice(flex.ExpandStringSet(d.Get("permission_set_arns").(*schema.Set)))
+	principals := expandAccountAssignmentsPrincipals(d.Get("principal").(*schema.Set).List())
+
+	o, _ := d.GetChange("assignment")
+	existing := expandAccountAssignmentKeys(o.(*schema.Set).List())
+	desired := accountAssignmentsMatrix(principals, permissionSetArns, accountIDs)
+
+	// Assignments that are added by this update must not already be managed elsewhere.
+	if add := accountAssignmentKeysDifference(desired, existing); len(add) > 0 {
+		current, err := findAccountAssignmentsInScope(conn, instanceArn, principals, permissionSetArns, accountIDs)
+
+		if err != nil {
+			return fmt.Errorf("error updating SSO Account Assignments (%s): %w", d.Id(), err)
+		}
+
+		if conflicts := accountAssignmentKeysIntersection(add, current); len(conflicts) > 0 {
+			return fmt.Errorf("error updating SSO Account Assignments (%s): %w", d.Id(), accountAssignmentsExistError(conflicts))
+		}
+	}
+
+	if err := updateAccountAssignments(conn, instanceArn, existing, desired, d.Get("max_concurrency").(int)); err != nil {
+		return fmt.Errorf("error updating SSO Account Assignments (%s): %w", d.Id(), err)
+	}
+
+	return resourceAccountAssignmentsRead(d, meta)
+}
+
+func resourceAccountAssignmentsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	existing := expandAccountAssignmentKeys(d.Get("assignment").(*schema.Set).List())
+
+	if err := updateAccountAssignments(conn, instanceArn, existing, nil, d.Get("max_concurrency").(int)); err != nil {
+		return fmt.Errorf("error deleting SSO Account Assignments (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceAccountAssignmentsCustomizeDiff expands the targets to accounts at plan time,
+// so that the plan shows every assignment that will be created or deleted.
+func resourceAccountAssignmentsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("target_account_ids") || !diff.NewValueKnown("target_organizational_unit_ids") {
+		if err := diff.SetNewComputed("account_ids"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("assignment")
+	}
+
+	accountIDs, err := findAccountAssignmentsTargetAccountIDs(meta.(*conns.AWSClient).OrganizationsConn, diff.Get("target_account_ids").(*schema.Set), diff.Get("target_organizational_unit_ids").(*schema.Set))
+
+	if err != nil {
+		return err
+	}
+
+	if err := diff.SetNew("account_ids", accountIDs); err != nil {
+		return err
+	}
+
+	if !diff.NewValueKnown("permission_set_arns") || !diff.NewValueKnown("principal") {
+		return diff.SetNewComputed("assignment")
+	}
+
+	permissionSetArns := aws.StringValueSlice(flex.ExpandStringSet(diff.Get("permission_set_arns").(*schema.Set)))
+	principals := expandAccountAssignmentsPrincipals(diff.Get("principal").(*schema.Set).List())
+
+	return diff.SetNew("assignment", flattenAccountAssignmentKeys(accountAssignmentsMatrix(principals, permissionSetArns, accountIDs)))
+}
+
+type accountAssignmentPrincipal struct {
+	principalID   string
+	principalType string
+}
+
+type accountAssignmentKey struct {
+	accountID        string
+	permissionSetArn string
+	principalID      string
+	principalType    string
+}
+
+func (k accountAssignmentKey) String() string {
+	return fmt.Sprintf("%s (%s) in AWS Account (%s) with Permission Set (%s)", k.principalType, k.principalID, k.accountID, k.permissionSetArn)
+}
+
+// accountAssignmentsMatrix returns an assignment for every combination of principal, permission set and account.
+func accountAssignmentsMatrix(principals []accountAssignmentPrincipal, permissionSetArns, accountIDs []string) []accountAssignmentKey {
+	keys := make([]accountAssignmentKey, 0, len(principals)*len(permissionSetArns)*len(accountIDs))
+
+	for _, principal := range principals {
+		for _, permissionSetArn := range permissionSetArns {
+			for _, accountID := range accountIDs {
+				keys = append(keys, accountAssignmentKey{
+					accountID:        accountID,
+					permissionSetArn: permissionSetArn,
+					principalID:      principal.principalID,
+					principalType:    principal.principalType,
+				})
+			}
+		}
+	}
+
+	return keys
+}
+
+// accountAssignmentKeysDifference returns the assignments in a that are not in b.
+func accountAssignmentKeysDifference(a, b []accountAssignmentKey) []accountAssignmentKey {
+	m := make(map[accountAssignmentKey]struct{}, len(b))
+
+	for _, key := range b {
+		m[key] = struct{}{}
+	}
+
+	var keys []accountAssignmentKey
+
+	for _, key := range a {
+		if _, ok := m[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// accountAssignmentKeysIntersection returns the assignments in a that are also in b.
+func accountAssignmentKeysIntersection(a, b []accountAssignmentKey) []accountAssignmentKey {
+	return accountAssignmentKeysDifference(a, accountAssignmentKeysDifference(a, b))
+}
+
+// accountAssignmentsExistError returns an error listing assignments that exist outside of the resource.
+func accountAssignmentsExistError(keys []accountAssignmentKey) error {
+	var errs *multierror.Error
+
+	for _, key := range keys {
+		errs = multierror.Append(errs, fmt.Errorf("%s already exists", key))
+	}
+
+	return fmt.Errorf("assignments that already exist cannot be managed, remove them or the matching principals, permission sets or accounts: %w", errs)
+}
+
+// findAccountAssignmentsTargetAccountIDs returns the target accounts and the active accounts within the target organizational units,
+// including those in child organizational units.
+func findAccountAssignmentsTargetAccountIDs(conn *organizations.Organizations, accountIDs, organizationalUnitIDs *schema.Set) ([]string, error) {
+	m := make(map[string]struct{})
+
+	for _, v := range accountIDs.List() {
+		m[v.(string)] = struct{}{}
+	}
+
+	parentIDs := aws.StringValueSlice(flex.ExpandStringSet(organizationalUnitIDs))
+	visited := make(map[string]struct{})
+
+	for len(parentIDs) > 0 {
+		parentID := parentIDs[0]
+		parentIDs = parentIDs[1:]
+
+		if _, ok := visited[parentID]; ok {
+			continue
+		}
+
+		visited[parentID] = struct{}{}
+
+		input := &organizations.ListAccountsForParentInput{
+			ParentId: aws.String(parentID),
+		}
+
+		err := conn.ListAccountsForParentPages(input, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, account := range page.Accounts {
+				if account == nil || aws.StringValue(account.Status) != organizations.AccountStatusActive {
+					continue
+				}
+
+				m[aws.StringValue(account.Id)] = struct{}{}
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing Organizations Organizational Unit (%s) accounts: %w", parentID, err)
+		}
+
+		childIDs, err := findAccountAssignmentsChildOrganizationalUnitIDs(conn, parentID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parentIDs = append(parentIDs, childIDs...)
+	}
+
+	ids := make([]string, 0, len(m))
+
+	for id := range m {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// findAccountAssignmentsChildOrganizationalUnitIDs returns the organizational units directly within the specified parent.
+func findAccountAssignmentsChildOrganizationalUnitIDs(conn *organizations.Organizations, parentID string) ([]string, error) {
+	input := &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: aws.String(parentID),
+	}
+	var ids []string
+
+	err := conn.ListOrganizationalUnitsForParentPages(input, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, ou := range page.OrganizationalUnits {
+			if ou == nil {
+				continue
+			}
+
+			ids = append(ids, aws.StringValue(ou.Id))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing Organizations Organizational Unit (%s) child organizational units: %w", parentID, err)
+	}
+
+	return ids, nil
+}
+
+// findAccountAssignmentsInScope returns the existing assignments of the principals for the permission sets and accounts.
+func findAccountAssignmentsInScope(conn *ssoadmin.SSOAdmin, instanceArn string, principals []accountAssignmentPrincipal, permissionSetArns, accountIDs []string) ([]accountAssignmentKey, error) {
+	permissionSets := make(map[string]struct{}, len(permissionSetArns))
+
+	for _, v := range permissionSetArns {
+		permissionSets[v] = struct{}{}
+	}
+
+	accounts := make(map[string]struct{}, len(accountIDs))
+
+	for _, v := range accountIDs {
+		accounts[v] = struct{}{}
+	}
+
+	var keys []accountAssignmentKey
+
+	for _, principal := range principals {
+		assignments, err := FindAccountAssignmentsForPrincipal(conn, principal.principalID, principal.principalType, instanceArn)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error listing SSO Account Assignments for %s (%s): %w", principal.principalType, principal.principalID, err)
+		}
+
+		for _, assignment := range assignments {
+			key := accountAssignmentKey{
+				accountID:        aws.StringValue(assignment.AccountId),
+				permissionSetArn: aws.StringValue(assignment.PermissionSetArn),
+				principalID:      aws.StringValue(assignment.PrincipalId),
+				principalType:    aws.StringValue(assignment.PrincipalType),
+			}
+
+			if _, ok := accounts[key.accountID]; !ok {
+				continue
+			}
+
+			if _, ok := permissionSets[key.permissionSetArn]; !ok {
+				continue
+			}
+
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// updateAccountAssignments deletes the assignments that are no longer wanted and creates the missing ones,
+// running at most maxConcurrency requests at a time.
+func updateAccountAssignments(conn *ssoadmin.SSOAdmin, instanceArn string, old, new []accountAssignmentKey, maxConcurrency int) error {
+	del := accountAssignmentKeysDifference(old, new)
+	add := accountAssignmentKeysDifference(new, old)
+
+	log.Printf("[DEBUG] Deleting %d and creating %d SSO Account Assignments", len(del), len(add))
+
+	if err := runAccountAssignments(del, maxConcurrency, func(key accountAssignmentKey) error {
+		return deleteAccountAssignment(conn, instanceArn, key)
+	}); err != nil {
+		return err
+	}
+
+	return runAccountAssignments(add, maxConcurrency, func(key accountAssignmentKey) error {
+		return createAccountAssignment(conn, instanceArn, key)
+	})
+}
+
+func runAccountAssignments(keys []accountAssignmentKey, maxConcurrency int, f func(accountAssignmentKey) error) error {
+	var (
+		errs *multierror.Error
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+	)
+
+	sem := make(chan struct{}, maxConcurrency)
+
+	for _, key := range keys {
+		key := key
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(key); err != nil {
+				mu.Lock()
+				errs = multierror.Append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func createAccountAssignment(conn *ssoadmin.SSOAdmin, instanceArn string, key accountAssignmentKey) error {
+	input := &ssoadmin.CreateAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(key.permissionSetArn),
+		PrincipalId:      aws.String(key.principalID),
+		PrincipalType:    aws.String(key.principalType),
+		TargetId:         aws.String(key.accountID),
+		TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(accountAssignmentsRetryTimeout, func() (interface{}, error) {
+		return conn.CreateAccountAssignment(input)
+	}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", key, err)
+	}
+
+	output := outputRaw.(*ssoadmin.CreateAccountAssignmentOutput)
+
+	if output == nil || output.AccountAssignmentCreationStatus == nil {
+		return fmt.Errorf("creating %s: empty output", key)
+	}
+
+	status, err := waitAccountAssignmentCreated(conn, instanceArn, aws.StringValue(output.AccountAssignmentCreationStatus.RequestId))
+
+	if err != nil {
+		if status != nil && status.FailureReason != nil {
+			return fmt.Errorf("waiting for %s to be created: %w: %s", key, err, aws.StringValue(status.FailureReason))
+		}
+
+		return fmt.Errorf("waiting for %s to be created: %w", key, err)
+	}
+
+	return nil
+}
+
+func deleteAccountAssignment(conn *ssoadmin.SSOAdmin, instanceArn string, key accountAssignmentKey) error {
+	input := &ssoadmin.DeleteAccountAssignmentInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(key.permissionSetArn),
+		PrincipalId:      aws.String(key.principalID),
+		PrincipalType:    aws.String(key.principalType),
+		TargetId:         aws.String(key.accountID),
+		TargetType:       aws.String(ssoadmin.TargetTypeAwsAccount),
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(accountAssignmentsRetryTimeout, func() (interface{}, error) {
+		return conn.DeleteAccountAssignment(input)
+	}, ssoadmin.ErrCodeConflictException, ssoadmin.ErrCodeThrottlingException)
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting %s: %w", key, err)
+	}
+
+	output := outputRaw.(*ssoadmin.DeleteAccountAssignmentOutput)
+
+	if output == nil || output.AccountAssignmentDeletionStatus == nil {
+		return fmt.Errorf("deleting %s: empty output", key)
+	}
+
+	status, err := waitAccountAssignmentDeleted(conn, instanceArn, aws.StringValue(output.AccountAssignmentDeletionStatus.RequestId))
+
+	if err != nil {
+		if status != nil && status.FailureReason != nil {
+			return fmt.Errorf("waiting for %s to be deleted: %w: %s", key, err, aws.StringValue(status.FailureReason))
+		}
+
+		return fmt.Errorf("waiting for %s to be deleted: %w", key, err)
+	}
+
+	return nil
+}
+
+func expandAccountAssignmentsPrincipals(tfList []interface{}) []accountAssignmentPrincipal {
+	var principals []accountAssignmentPrincipal
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		principals = append(principals, accountAssignmentPrincipal{
+			principalID:   tfMap["principal_id"].(string),
+			principalType: tfMap["principal_type"].(string),
+		})
+	}
+
+	return principals
+}
+
+func expandAccountAssignmentKeys(tfList []interface{}) []accountAssignmentKey {
+	var keys []accountAssignmentKey
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		keys = append(keys, accountAssignmentKey{
+			accountID:        tfMap["account_id"].(string),
+			permissionSetArn: tfMap["permission_set_arn"].(string),
+			principalID:      tfMap["principal_id"].(string),
+			principalType:    tfMap["principal_type"].(string),
+		})
+	}
+
+	return keys
+}
+
+func flattenAccountAssignmentKeys(keys []accountAssignmentKey) []interface{} {
+	tfList := make([]interface{}, 0, len(keys))
+
+	for _, key := range keys {
+		tfList = append(tfList, map[string]interface{}{
+			"account_id":         key.accountID,
+			"permission_set_arn": key.permissionSetArn,
+			"principal_id":       key.principalID,
+			"principal_type":     key.principalType,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,333 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+)
+
+func TestAccSSOAdminAccountAssignments_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_caller_identity.current", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "assignment.*", map[string]string{
+						"principal_type": "GROUP",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principal.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_update(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "3"),
+				),
+			},
+			{
+				Config: testAccAccountAssignmentsConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "permission_set_arns.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_organizationalUnit(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckOrganizationManagementAccount(t)
+			testAccPreCheckInstances(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsOrganizationalUnitConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "assignment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target_organizational_unit_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_existingAssignment(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsExistingAssignmentBaseConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentExists("aws_ssoadmin_account_assignment.test"),
+				),
+			},
+			{
+				Config:      testAccAccountAssignmentsExistingAssignmentConfig(rName),
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminAccountAssignments_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_account_assignments.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAccountAssignmentsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentsConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentsExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourceAccountAssignments(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testAccAccountAssignmentsFromState returns the assignments recorded in the state of the resource.
+func testAccAccountAssignmentsFromState(rs *terraform.ResourceState) []map[string]string {
+	var assignments []map[string]string
+
+	for k := range rs.Primary.Attributes {
+		if !strings.HasPrefix(k, "assignment.") || !strings.HasSuffix(k, ".account_id") {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(k, "account_id")
+		assignments = append(assignments, map[string]string{
+			"account_id":         rs.Primary.Attributes[prefix+"account_id"],
+			"permission_set_arn": rs.Primary.Attributes[prefix+"permission_set_arn"],
+			"principal_id":       rs.Primary.Attributes[prefix+"principal_id"],
+			"principal_type":     rs.Primary.Attributes[prefix+"principal_type"],
+		})
+	}
+
+	return assignments
+}
+
+func testAccCheckAccountAssignmentsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_account_assignments" {
+			continue
+		}
+
+		instanceArn := rs.Primary.Attributes["instance_arn"]
+
+		for _, a := range testAccAccountAssignmentsFromState(rs) {
+			accountAssignment, err := tfssoadmin.FindAccountAssignment(conn, a["principal_id"], a["principal_type"], a["account_id"], a["permission_set_arn"], instanceArn)
+
+			if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("error reading SSO Account Assignment for Principal (%s): %w", a["principal_id"], err)
+			}
+
+			if accountAssignment != nil {
+				return fmt.Errorf("SSO Account Assignment for Principal (%s) in AWS Account (%s) still exists", a["principal_id"], a["account_id"])
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAccountAssignmentsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		instanceArn := rs.Primary.Attributes["instance_arn"]
+
+		for _, a := range testAccAccountAssignmentsFromState(rs) {
+			accountAssignment, err := tfssoadmin.FindAccountAssignment(conn, a["principal_id"], a["principal_type"], a["account_id"], a["permission_set_arn"], instanceArn)
+
+			if err != nil {
+				return err
+			}
+
+			if accountAssignment == nil {
+				return fmt.Errorf("Account Assignment for Principal (%s) in AWS Account (%s) not found", a["principal_id"], a["account_id"])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAccountAssignmentsBaseConfig(rName string, permissionSetCount int) string {
+	return fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_ssoadmin_permission_set" "test" {
+  count = %[2]d
+
+  name         = "%[1]s-${count.index}"
+  instance_arn = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+}
+`, rName, permissionSetCount)
+}
+
+func testAccAccountAssignmentsConfig(rName string, permissionSetCount int) string {
+	return acctest.ConfigCompose(
+		testAccAccountAssignmentsBaseConfig(rName, permissionSetCount),
+		`
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn        = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns = aws_ssoadmin_permission_set.test[*].arn
+  target_account_ids  = [data.aws_caller_identity.current.account_id]
+
+  principal {
+    principal_id   = aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+  }
+}
+`)
+}
+
+func testAccAccountAssignmentsOrganizationalUnitConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAccountAssignmentsBaseConfig(rName, 1),
+		fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_organizations_organizational_unit" "test" {
+  name      = %[1]q
+  parent_id = data.aws_organizations_organization.test.roots[0].id
+}
+
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn                   = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns            = aws_ssoadmin_permission_set.test[*].arn
+  target_account_ids             = [data.aws_caller_identity.current.account_id]
+  target_organizational_unit_ids = [aws_organizations_organizational_unit.test.id]
+
+  principal {
+    principal_id   = aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+  }
+}
+`, rName))
+}
+
+func testAccAccountAssignmentsExistingAssignmentBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAccountAssignmentsBaseConfig(rName, 2),
+		`
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arn = aws_ssoadmin_permission_set.test[0].arn
+  principal_id       = aws_identitystore_group.test.group_id
+  principal_type     = "GROUP"
+  target_id          = data.aws_caller_identity.current.account_id
+  target_type        = "AWS_ACCOUNT"
+}
+`)
+}
+
+func testAccAccountAssignmentsExistingAssignmentConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccAccountAssignmentsExistingAssignmentBaseConfig(rName),
+		`
+resource "aws_ssoadmin_account_assignments" "test" {
+  instance_arn        = tolist(data.aws_ssoadmin_instances.test.arns)[0]
+  permission_set_arns = aws_ssoadmin_permission_set.test[*].arn
+  target_account_ids  = [data.aws_caller_identity.current.account_id]
+
+  principal {
+    principal_id   = aws_identitystore_group.test.group_id
+    principal_type = "GROUP"
+  }
+
+  depends_on = [aws_ssoadmin_account_assignment.test]
+}
+`)
+}
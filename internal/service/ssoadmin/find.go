@@ -69,3 +69,82 @@ func FindManagedPolicy(conn *ssoadmin.SSOAdmin, managedPolicyArn, permissionSetA
 
 	return attachedPolicy, err
 }
+
+// FindCustomerManagedPolicy returns the customer managed policy attached to a permission set within a specified SSO instance.
+// Returns an error if no customer managed policy is found.
+func FindCustomerManagedPolicy(conn *ssoadmin.SSOAdmin, policyName, policyPath, permissionSetArn, instanceArn string) (*ssoadmin.CustomerManagedPolicyReference, error) {
+	input := &ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	var policyReference *ssoadmin.CustomerManagedPolicyReference
+	err := conn.ListCustomerManagedPolicyReferencesInPermissionSetPages(input, func(page *ssoadmin.ListCustomerManagedPolicyReferencesInPermissionSetOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, policy := range page.CustomerManagedPolicyReferences {
+			if policy == nil {
+				continue
+			}
+
+			if aws.StringValue(policy.Name) == policyName && aws.StringValue(policy.Path) == policyPath {
+				policyReference = policy
+				return false
+			}
+		}
+		return !lastPage
+	})
+
+	return policyReference, err
+}
+
+// FindPermissionsBoundary returns the permissions boundary attached to a permission set within a specified SSO instance.
+// Returns an error if no permissions boundary is found.
+func FindPermissionsBoundary(conn *ssoadmin.SSOAdmin, permissionSetArn, instanceArn string) (*ssoadmin.PermissionsBoundary, error) {
+	input := &ssoadmin.GetPermissionsBoundaryForPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	output, err := conn.GetPermissionsBoundaryForPermissionSet(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.PermissionsBoundary, nil
+}
+
+// FindAccountAssignmentsForPrincipal returns the account assignments of a principal within a specified SSO instance.
+func FindAccountAssignmentsForPrincipal(conn *ssoadmin.SSOAdmin, principalId, principalType, instanceArn string) ([]*ssoadmin.AccountAssignmentForPrincipal, error) {
+	input := &ssoadmin.ListAccountAssignmentsForPrincipalInput{
+		InstanceArn:   aws.String(instanceArn),
+		PrincipalId:   aws.String(principalId),
+		PrincipalType: aws.String(principalType),
+	}
+
+	var accountAssignments []*ssoadmin.AccountAssignmentForPrincipal
+	err := conn.ListAccountAssignmentsForPrincipalPages(input, func(page *ssoadmin.ListAccountAssignmentsForPrincipalOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, a := range page.AccountAssignments {
+			if a == nil {
+				continue
+			}
+
+			accountAssignments = append(accountAssignments, a)
+		}
+
+		return !lastPage
+	})
+
+	return accountAssignments, err
+}
@@ -0,0 +1,196 @@
+package ssoadmin
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePermissionsBoundaryAttachment() *schema.Resource {
+	policyReferenceSchema := customerManagedPolicyReferenceSchema(false)
+	policyReferenceSchema.ExactlyOneOf = []string{"permissions_boundary.0.customer_managed_policy_reference", "permissions_boundary.0.managed_policy_arn"}
+
+	return &schema.Resource{
+		Create: resourcePermissionsBoundaryAttachmentCreate,
+		Read:   resourcePermissionsBoundaryAttachmentRead,
+		Delete: resourcePermissionsBoundaryAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+
+			"permissions_boundary": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_policy_reference": policyReferenceSchema,
+						"managed_policy_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"permissions_boundary.0.customer_managed_policy_reference", "permissions_boundary.0.managed_policy_arn"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePermissionsBoundaryAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+
+	input := &ssoadmin.PutPermissionsBoundaryToPermissionSetInput{
+		InstanceArn:         aws.String(instanceArn),
+		PermissionSetArn:    aws.String(permissionSetArn),
+		PermissionsBoundary: expandPermissionsBoundary(d.Get("permissions_boundary").([]interface{})),
+	}
+
+	_, err := conn.PutPermissionsBoundaryToPermissionSet(input)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Permissions Boundary to SSO Permission Set (%s): %w", permissionSetArn, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", permissionSetArn, instanceArn))
+
+	// Provision ALL accounts after attaching the permissions boundary
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return resourcePermissionsBoundaryAttachmentRead(d, meta)
+}
+
+func resourcePermissionsBoundaryAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	permissionSetArn, instanceArn, err := ParsePermissionsBoundaryAttachmentID(d.Id())
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permissions Boundary Attachment ID: %w", err)
+	}
+
+	boundary, err := FindPermissionsBoundary(conn, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Permissions Boundary for SSO Permission Set (%s) not found, removing from state", permissionSetArn)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Permissions Boundary for SSO Permission Set (%s): %w", permissionSetArn, err)
+	}
+
+	if boundary == nil {
+		log.Printf("[WARN] Permissions Boundary for SSO Permission Set (%s) not found, removing from state", permissionSetArn)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", permissionSetArn)
+	if err := d.Set("permissions_boundary", flattenPermissionsBoundary(boundary)); err != nil {
+		return fmt.Errorf("error setting permissions_boundary: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePermissionsBoundaryAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	permissionSetArn, instanceArn, err := ParsePermissionsBoundaryAttachmentID(d.Id())
+	if err != nil {
+		return fmt.Errorf("error parsing SSO Permissions Boundary Attachment ID: %w", err)
+	}
+
+	input := &ssoadmin.DeletePermissionsBoundaryFromPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	}
+
+	_, err = conn.DeletePermissionsBoundaryFromPermissionSet(input)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			return nil
+		}
+		return fmt.Errorf("error detaching Permissions Boundary from SSO Permission Set (%s): %w", permissionSetArn, err)
+	}
+
+	// Provision ALL accounts after detaching the permissions boundary
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ParsePermissionsBoundaryAttachmentID(id string) (string, string, error) {
+	idParts := strings.Split(id, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("error parsing ID: expected PERMISSION_SET_ARN,INSTANCE_ARN")
+	}
+	return idParts[0], idParts[1], nil
+}
+
+func expandPermissionsBoundary(tfList []interface{}) *ssoadmin.PermissionsBoundary {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &ssoadmin.PermissionsBoundary{}
+
+	if v, ok := tfMap["customer_managed_policy_reference"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomerManagedPolicyReference = expandCustomerManagedPolicyReference(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_policy_arn"].(string); ok && v != "" {
+		apiObject.ManagedPolicyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPermissionsBoundary(apiObject *ssoadmin.PermissionsBoundary) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"customer_managed_policy_reference": flattenCustomerManagedPolicyReference(apiObject.CustomerManagedPolicyReference),
+		"managed_policy_arn":                aws.StringValue(apiObject.ManagedPolicyArn),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,197 @@
+package ssoadmin_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssoadmin "github.com/hashicorp/terraform-provider-aws/internal/service/ssoadmin"
+)
+
+func TestAccSSOAdminPermissionsBoundaryAttachment_basic(t *testing.T) {
+	resourceName := "aws_ssoadmin_permissions_boundary_attachment.test"
+	permissionSetResourceName := "aws_ssoadmin_permission_set.test"
+	policyResourceName := "aws_iam_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBoundaryAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminPermissionsBoundaryAttachmentConfig_customerManagedPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_arn", permissionSetResourceName, "instance_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "permission_set_arn", permissionSetResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "permissions_boundary.0.customer_managed_policy_reference.0.name", policyResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.0.path", "/"),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.managed_policy_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionsBoundaryAttachment_managedPolicy(t *testing.T) {
+	resourceName := "aws_ssoadmin_permissions_boundary_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBoundaryAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminPermissionsBoundaryAttachmentConfig_managedPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary.0.customer_managed_policy_reference.#", "0"),
+					//lintignore:AWSAT001
+					resource.TestMatchResourceAttr(resourceName, "permissions_boundary.0.managed_policy_arn", regexp.MustCompile(`policy/ReadOnlyAccess$`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSSOAdminPermissionsBoundaryAttachment_disappears(t *testing.T) {
+	resourceName := "aws_ssoadmin_permissions_boundary_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckInstances(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssoadmin.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPermissionsBoundaryAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSOAdminPermissionsBoundaryAttachmentConfig_managedPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsBoundaryAttachmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfssoadmin.ResourcePermissionsBoundaryAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsBoundaryAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ssoadmin_permissions_boundary_attachment" {
+			continue
+		}
+
+		permissionSetArn, instanceArn, err := tfssoadmin.ParsePermissionsBoundaryAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Permissions Boundary Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		boundary, err := tfssoadmin.FindPermissionsBoundary(conn, permissionSetArn, instanceArn)
+
+		if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if boundary == nil {
+			continue
+		}
+
+		return fmt.Errorf("Permissions Boundary for SSO Permission Set (%s) still exists", permissionSetArn)
+	}
+
+	return nil
+}
+
+func testAccCheckPermissionsBoundaryAttachmentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Resource (%s) ID not set", resourceName)
+		}
+
+		permissionSetArn, instanceArn, err := tfssoadmin.ParsePermissionsBoundaryAttachmentID(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error parsing SSO Permissions Boundary Attachment ID (%s): %w", rs.Primary.ID, err)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSOAdminConn
+
+		boundary, err := tfssoadmin.FindPermissionsBoundary(conn, permissionSetArn, instanceArn)
+
+		if err != nil {
+			return err
+		}
+
+		if boundary == nil {
+			return fmt.Errorf("Permissions Boundary for SSO Permission Set (%s) not found", permissionSetArn)
+		}
+
+		return nil
+	}
+}
+
+func testAccSSOAdminPermissionsBoundaryAttachmentConfig_customerManagedPolicy(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSSOAdminCustomerManagedPolicyAttachmentBaseConfig(rName, "/"),
+		`
+resource "aws_ssoadmin_permissions_boundary_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  permissions_boundary {
+    customer_managed_policy_reference {
+      name = aws_iam_policy.test.name
+    }
+  }
+}
+`)
+}
+
+func testAccSSOAdminPermissionsBoundaryAttachmentConfig_managedPolicy(rName string) string {
+	return acctest.ConfigCompose(
+		testAccSSOAdminManagedPolicyAttachmentBaseConfig(rName),
+		`
+resource "aws_ssoadmin_permissions_boundary_attachment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+
+  permissions_boundary {
+    managed_policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/ReadOnlyAccess"
+  }
+}
+`)
+}